@@ -2,9 +2,6 @@ package main
 
 import (
 	"context"
-	"crypto/ecdsa"
-	"crypto/x509"
-	"encoding/pem"
 	"errors"
 	"fmt"
 	"net"
@@ -18,10 +15,17 @@ import (
 	"ldt-hack/api/internal/admin"
 	"ldt-hack/api/internal/app/v1"
 	"ldt-hack/api/internal/auth"
+	"ldt-hack/api/internal/blob"
 	"ldt-hack/api/internal/bot"
+	"ldt-hack/api/internal/crypto"
+	"ldt-hack/api/internal/mail"
+	"ldt-hack/api/internal/oidc"
+	"ldt-hack/api/internal/passwords"
 	"ldt-hack/api/internal/platform"
 	"ldt-hack/api/internal/platform/config"
+	"ldt-hack/api/internal/slots"
 	"ldt-hack/api/internal/storage"
+	"ldt-hack/api/internal/waitlist"
 
 	"github.com/gin-gonic/gin"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
@@ -45,6 +49,10 @@ var rootCmd = &cobra.Command{
 	SilenceUsage:  true,
 	RunE: func(cmd *cobra.Command, _ []string) error {
 		platform.Init()
+		if err := config.Validate(); err != nil {
+			return fmt.Errorf("validating config: %w", err)
+		}
+
 		logger := platform.NewLogger(viper.GetString(config.LogLevel))
 
 		return runAPI(cmd.Context(), logger)
@@ -65,24 +73,96 @@ func runAPI(ctx context.Context, logger *slog.Logger) error {
 	}
 
 	// Initialize authorizer
-	jwtKey, err := readJWTKey(viper.GetString(config.JWTPath))
+	jwtPath := viper.GetString(config.JWTPath)
+	jwtKeys, err := auth.LoadKeySet(jwtPath)
 	if err != nil {
 		return err
 	}
 
-	authorizer, err := auth.NewAuthorizer(jwtKey)
+	authorizer, err := auth.NewAuthorizer(jwtKeys)
 	if err != nil {
 		return fmt.Errorf("creating authorizer: %w", err)
 	}
 
+	reloadCtx, stopReload := context.WithCancel(ctx)
+	defer stopReload()
+	go reloadJWTKeys(reloadCtx, logger, authorizer, jwtPath, jwtKeys, viper.GetDuration(config.JWTReloadInterval))
+
 	// Initialize rasa bot
 	botClient, err := bot.NewClient(viper.GetString(config.RasaURL))
 	if err != nil {
 		return fmt.Errorf("creating rasa bot: %w", err)
 	}
 
+	// Initialize mail sender
+	mailSender, err := mail.NewSender(viper.GetString(config.MailSender), logger, viper.GetString(config.MailDir))
+	if err != nil {
+		return fmt.Errorf("creating mail sender: %w", err)
+	}
+
+	// Initialize blob store for the appointment attachments
+	blobStore, err := blob.NewStore(viper.GetString(config.BlobStore), viper.GetString(config.BlobDir))
+	if err != nil {
+		return fmt.Errorf("creating blob store: %w", err)
+	}
+
+	// Initialize the password hashing and the policy shared by the users and the admin
+	err = crypto.SetPasswordParams(crypto.Argon2Params{
+		Memory:     viper.GetUint32(config.PasswordArgon2Memory),
+		Iterations: viper.GetUint32(config.PasswordArgon2Iterations),
+		Threads:    uint8(viper.GetUint(config.PasswordArgon2Threads)),
+		SaltLength: viper.GetUint32(config.PasswordArgon2SaltLength),
+		KeyLength:  viper.GetUint32(config.PasswordArgon2KeyLength),
+	})
+	if err != nil {
+		return fmt.Errorf("setting password hashing parameters: %w", err)
+	}
+
+	passwordPolicy, err := passwords.NewPolicy(
+		viper.GetInt(config.PasswordMinLength),
+		viper.GetInt(config.PasswordMaxLength),
+		viper.GetInt(config.PasswordMinClasses),
+		viper.GetString(config.PasswordDenyList),
+	)
+	if err != nil {
+		return fmt.Errorf("creating password policy: %w", err)
+	}
+
+	// Initialize external identity provider, if configured
+	var identityProvider *oidc.Provider
+	if issuer := viper.GetString(config.OIDCIssuer); issuer != "" {
+		identityProvider = oidc.NewProvider(oidc.Config{
+			Name:         viper.GetString(config.OIDCName),
+			Issuer:       issuer,
+			ClientID:     viper.GetString(config.OIDCClientID),
+			ClientSecret: viper.GetString(config.OIDCClientSecret),
+			RedirectURL:  viper.GetString(config.OIDCRedirectURL),
+			Scopes:       viper.GetStringSlice(config.OIDCScopes),
+		}, nil)
+	}
+
+	// Initialize waitlist processing, which books the slots opening up for the waiting business users
+	waitlistProcessor := waitlist.NewProcessor(logger, db, mailSender)
+
+	waitlistCtx, stopWaitlist := context.WithCancel(ctx)
+	defer stopWaitlist()
+	go waitlistProcessor.Run(waitlistCtx, viper.GetDuration(config.WaitlistInterval))
+
 	// Initialize gRPC services
-	appService := app.NewService(logger, db, botClient, authorizer)
+	appService := app.NewService(logger, db, botClient, authorizer, mailSender, passwordPolicy, identityProvider,
+		app.CacheOptions{
+			Size: viper.GetInt(config.CacheSize),
+			TTL:  viper.GetDuration(config.CacheTTL),
+		}, waitlistProcessor, viper.GetDuration(config.SlotHoldTTL), blobStore)
+
+	watchCtx, stopWatch := context.WithCancel(ctx)
+	defer stopWatch()
+	go appService.WatchInvalidations(watchCtx)
+
+	sweepCtx, stopSweep := context.WithCancel(ctx)
+	defer stopSweep()
+	go appService.SweepSlotHolds(sweepCtx, viper.GetDuration(config.SlotHoldSweepInterval))
+	go appService.SweepAttachmentBlobs(sweepCtx, viper.GetDuration(config.BlobSweepInterval))
 
 	// Initialize actual gRPC server
 	grpcAddr := viper.GetString(config.GRPCAddr)
@@ -91,12 +171,28 @@ func runAPI(ctx context.Context, logger *slog.Logger) error {
 		return fmt.Errorf("starting gRPC server: %w", err)
 	}
 
+	// Initialize consultation slot generation from the slot templates
+	slotGenerator := slots.NewGenerator(logger, db, viper.GetDuration(config.SlotsHorizon))
+
+	generateCtx, stopGenerate := context.WithCancel(ctx)
+	defer stopGenerate()
+	go slotGenerator.Run(generateCtx, viper.GetDuration(config.SlotsGenerateInterval))
+
 	// Initialize admin HTTP service
-	adminService, err := admin.NewService(logger, db, authorizer, viper.GetString(config.AdminCredentials))
+	adminService, err := admin.NewService(logger, db, authorizer, passwordPolicy, admin.SessionOptions{
+		Secure:      viper.GetBool(config.AdminCookieSecure),
+		SameSite:    viper.GetString(config.AdminCookieSameSite),
+		IdleTimeout: viper.GetDuration(config.AdminSessionIdleTimeout),
+		MaxAge:      viper.GetDuration(config.AdminSessionMaxAge),
+	}, slotGenerator, waitlistProcessor)
 	if err != nil {
 		return fmt.Errorf("creating admin service: %w", err)
 	}
 
+	if err := adminService.SeedSuperAdmin(ctx, viper.GetString(config.AdminCredentials)); err != nil {
+		return fmt.Errorf("seeding admin: %w", err)
+	}
+
 	// Initialize HTTP server
 	httpAddr := viper.GetString(config.HTTPAddr)
 	httpServer, httpCh := startHTTP(httpAddr,
@@ -161,29 +257,59 @@ func runAPI(ctx context.Context, logger *slog.Logger) error {
 	return nil
 }
 
-func readJWTKey(path string) (*ecdsa.PrivateKey, error) {
-	data, err := os.ReadFile(path)
-	if err != nil {
-		return nil, fmt.Errorf("reading JWT private key from %s: %w", path, err)
-	}
+// reloadJWTKeys periodically reloads the JWT key set, allowing keys to be rotated without restarting.
+// Reloading can also be triggered manually by sending SIGHUP.
+func reloadJWTKeys(ctx context.Context,
+	logger *slog.Logger, authorizer *auth.Authorizer,
+	path string, current *auth.KeySet, interval time.Duration,
+) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	hupCh := make(chan os.Signal, 1)
+	signal.Notify(hupCh, syscall.SIGHUP)
+	defer signal.Stop(hupCh)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-hupCh:
+		}
 
-	block, _ := pem.Decode(data)
-	if block == nil {
-		return nil, fmt.Errorf("failed to decode JWT private key from %s as PEM", path)
-	}
+		keys, err := auth.LoadKeySet(path)
+		if err != nil {
+			logger.Error("failed to reload JWT keys, will continue using the current ones", "error", err)
+			continue
+		}
 
-	key, err := x509.ParseECPrivateKey(block.Bytes)
-	if err != nil {
-		return nil, fmt.Errorf("parsing JWT private key from %s as ECDSA private key: %w", path, err)
-	}
+		if err := authorizer.SetKeys(keys); err != nil {
+			logger.Error("failed to update JWT keys, will continue using the current ones", "error", err)
+			continue
+		}
 
-	return key, nil
+		if keys.SigningKeyID != current.SigningKeyID || len(keys.Keys) != len(current.Keys) {
+			logger.Info("reloaded JWT keys",
+				"signing_key_id", keys.SigningKeyID,
+				"previous_signing_key_id", current.SigningKeyID,
+				"num_keys", len(keys.Keys),
+			)
+		}
+		current = keys
+	}
 }
 
 func startGRPC(addr string,
 	logger *slog.Logger, authorizer *auth.Authorizer,
 	appService *app.Service,
 ) (*grpc.Server, chan error, error) {
+	// Refuse to start if any of the methods are missing an access policy
+	policies, err := app.Policies()
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading access policies: %w", err)
+	}
+
 	listener, err := net.Listen("tcp", addr)
 	if err != nil {
 		return nil, nil, fmt.Errorf("listening on bind address %q: %w", addr, err)
@@ -199,10 +325,7 @@ func startGRPC(addr string,
 				}),
 				logging.WithLogOnEvents(logging.StartCall, logging.FinishCall),
 			),
-			auth.UnaryInterceptor[app.Session](authorizer,
-				"/ldt_hack.app.v1.AppService/CreateBusinessUser",
-				"/ldt_hack.app.v1.AppService/CreateSession",
-			),
+			auth.UnaryInterceptor(authorizer, appService.ValidateSession, appService.ResolveAPIKey, policies),
 		),
 	)
 	reflection.Register(server)
@@ -0,0 +1,37 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+
+	"ldt-hack/api/internal/storage"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+func (s *Service) accountRoleHandler(c *gin.Context) {
+	var req accountRoleRequest
+	if err := c.Bind(&req); err != nil {
+		return
+	}
+
+	role := storage.AccountRole(req.Role)
+	if !lo.ContainsBy(lo.Values(storage.AccountTypeRoles), func(roles []storage.AccountRole) bool {
+		return lo.Contains(roles, role)
+	}) {
+		c.AbortWithStatusJSON(http.StatusBadRequest, apiError{"Указана неизвестная роль"})
+		return
+	}
+
+	if err := s.db.UpdateAccountRole(c, req.Email, role); errors.Is(err, storage.ErrNotFound) {
+		c.AbortWithStatusJSON(http.StatusNotFound, apiError{"Пользователь не найден или роль не подходит для его типа"})
+		return
+	} else if err != nil {
+		s.logger.Error("failed to update account role in database", "email", req.Email, "role", role, "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
@@ -0,0 +1,179 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"ldt-hack/api/internal/crypto"
+	"ldt-hack/api/internal/storage"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+var adminRoles = []storage.AdminRole{storage.AdminRoleSuperAdmin, storage.AdminRoleAdmin, storage.AdminRoleViewer}
+
+func (s *Service) listAdminsHandler(c *gin.Context) {
+	admins, err := s.db.ListAdminUsers(c)
+	if err != nil {
+		s.logger.Error("failed to list admins in database", "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.JSON(http.StatusOK, lo.Map(admins, func(a storage.AdminUser, _ int) adminUser {
+		return adminUserFromStorage(a)
+	}))
+}
+
+func (s *Service) createAdminHandler(c *gin.Context) {
+	var req createAdminRequest
+	if err := c.Bind(&req); err != nil {
+		return
+	}
+
+	role := storage.AdminRole(req.Role)
+	if !lo.Contains(adminRoles, role) {
+		c.AbortWithStatusJSON(http.StatusBadRequest, apiError{"Указана неизвестная роль администратора"})
+		return
+	} else if err := s.passwords.Validate(req.Password); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, apiError{err.Error()})
+		return
+	}
+
+	passwordHash, err := crypto.HashPassword(req.Password)
+	if err != nil {
+		s.logger.Error("failed to hash password", "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	id, err := s.db.CreateAdminUser(c, storage.AdminUser{
+		Username:     req.Username,
+		PasswordHash: passwordHash,
+		Role:         role,
+	})
+	if errors.Is(err, storage.ErrAlreadyExists) {
+		c.AbortWithStatusJSON(http.StatusConflict, apiError{"Администратор с таким именем уже существует"})
+		return
+	} else if err != nil {
+		s.logger.Error("failed to create admin in database", "username", req.Username, "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"id": id})
+}
+
+func (s *Service) getAdminHandler(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	admin, err := s.db.GetAdminUser(c, id)
+	if errors.Is(err, storage.ErrNotFound) {
+		c.AbortWithStatusJSON(http.StatusNotFound, apiError{"Администратор не найден"})
+		return
+	} else if err != nil {
+		s.logger.Error("failed to get admin from database", "admin_id", id, "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.JSON(http.StatusOK, adminUserFromStorage(admin))
+}
+
+func (s *Service) updateAdminHandler(c *gin.Context) {
+	var req updateAdminRequest
+	if err := c.Bind(&req); err != nil {
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	var update storage.AdminUserUpdate
+	if req.Role != nil {
+		role := storage.AdminRole(*req.Role)
+		if !lo.Contains(adminRoles, role) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, apiError{"Указана неизвестная роль администратора"})
+			return
+		}
+
+		update.Role = &role
+	}
+
+	if req.Password != nil {
+		if err := s.passwords.Validate(*req.Password); err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, apiError{err.Error()})
+			return
+		}
+
+		if update.PasswordHash, err = crypto.HashPassword(*req.Password); err != nil {
+			s.logger.Error("failed to hash password", "error", err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+	}
+
+	update.Disabled = req.Disabled
+
+	// Super admins can't lock themselves out, which also guarantees that at least one super admin remains
+	if current, _ := adminFromCtx(c); current.ID == id &&
+		((update.Role != nil && *update.Role != storage.AdminRoleSuperAdmin) || (update.Disabled != nil && *update.Disabled)) {
+		c.AbortWithStatusJSON(http.StatusBadRequest, apiError{"Нельзя отключить себя или понизить свою роль"})
+		return
+	}
+
+	if err := s.db.UpdateAdminUser(c, id, update); errors.Is(err, storage.ErrNotFound) {
+		c.AbortWithStatusJSON(http.StatusNotFound, apiError{"Администратор не найден"})
+		return
+	} else if err != nil {
+		s.logger.Error("failed to update admin in database", "admin_id", id, "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (s *Service) deleteAdminHandler(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	if current, _ := adminFromCtx(c); current.ID == id {
+		c.AbortWithStatusJSON(http.StatusBadRequest, apiError{"Нельзя удалить самого себя"})
+		return
+	}
+
+	if err := s.db.DeleteAdminUser(c, id); errors.Is(err, storage.ErrNotFound) {
+		c.AbortWithStatusJSON(http.StatusNotFound, apiError{"Администратор не найден"})
+		return
+	} else if err != nil {
+		s.logger.Error("failed to delete admin from database", "admin_id", id, "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func adminUserFromStorage(a storage.AdminUser) adminUser {
+	return adminUser{
+		ID:          a.ID,
+		Username:    a.Username,
+		Role:        string(a.Role),
+		Disabled:    a.Disabled,
+		CreatedAt:   a.CreatedAt,
+		LastLoginAt: a.LastLoginAt,
+	}
+}
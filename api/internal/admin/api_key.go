@@ -0,0 +1,110 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"ldt-hack/api/internal/crypto"
+	"ldt-hack/api/internal/storage"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+var apiKeyScopes = []storage.APIKeyScope{storage.APIKeyScopeRead, storage.APIKeyScopeWrite}
+
+func (s *Service) listAPIKeysHandler(c *gin.Context) {
+	authorityID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	keys, err := s.db.ListAuthorityAPIKeys(c, authorityID)
+	if err != nil {
+		s.logger.Error("failed to list api keys in database", "authority_id", authorityID, "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.JSON(http.StatusOK, lo.Map(keys, func(k storage.APIKey, _ int) apiKey {
+		return apiKey{
+			ID:         k.ID,
+			Name:       k.Name,
+			Scope:      string(k.Scope),
+			CreatedAt:  k.CreatedAt,
+			ExpiresAt:  k.ExpiresAt,
+			LastUsedAt: k.LastUsedAt,
+			RevokedAt:  k.RevokedAt,
+		}
+	}))
+}
+
+func (s *Service) createAPIKeyHandler(c *gin.Context) {
+	var req createAPIKeyRequest
+	if err := c.Bind(&req); err != nil {
+		return
+	}
+
+	scope := storage.APIKeyScope(req.Scope)
+	if !lo.Contains(apiKeyScopes, scope) {
+		c.AbortWithStatusJSON(http.StatusBadRequest, apiError{"Указана неизвестная область действия ключа"})
+		return
+	} else if req.ExpiresAt != nil && req.ExpiresAt.Before(time.Now()) {
+		c.AbortWithStatusJSON(http.StatusBadRequest, apiError{"Срок действия ключа должен быть в будущем"})
+		return
+	}
+
+	authorityID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	key, err := crypto.GenerateToken()
+	if err != nil {
+		s.logger.Error("failed to generate api key", "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	// Only the hash is stored, so the key can't be shown again after this response
+	id, err := s.db.CreateAPIKey(c, storage.APIKey{
+		AuthorityID: authorityID,
+		Name:        req.Name,
+		KeyHash:     crypto.HashToken(key),
+		Scope:       scope,
+		ExpiresAt:   req.ExpiresAt,
+	})
+	if errors.Is(err, storage.ErrNotFound) {
+		c.AbortWithStatusJSON(http.StatusNotFound, apiError{"Ведомство не найдено"})
+		return
+	} else if err != nil {
+		s.logger.Error("failed to create api key in database", "authority_id", authorityID, "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.JSON(http.StatusCreated, createAPIKeyResponse{ID: id, Key: key})
+}
+
+func (s *Service) revokeAPIKeyHandler(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	if err := s.db.RevokeAPIKey(c, id); errors.Is(err, storage.ErrNotFound) {
+		c.AbortWithStatusJSON(http.StatusNotFound, apiError{"Ключ не найден или уже отозван"})
+		return
+	} else if err != nil {
+		s.logger.Error("failed to revoke api key in database", "api_key_id", id, "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
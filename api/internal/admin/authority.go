@@ -2,9 +2,11 @@ package admin
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strconv"
 
+	"ldt-hack/api/internal/assignment"
 	"ldt-hack/api/internal/crypto"
 	"ldt-hack/api/internal/excel"
 	"ldt-hack/api/internal/storage"
@@ -34,7 +36,7 @@ func (s *Service) authorityInfoHandler(c *gin.Context) {
 		return
 	}
 
-	s.db.WithTx(c, false, func(ctx context.Context, tx bun.Tx) error {
+	err = s.db.WithTx(c, false, func(ctx context.Context, tx bun.Tx) error {
 		// Create new authorities
 		authorities, err := s.db.CreateAuthoritiesTx(ctx, tx, lo.Map(authorityInfo, func(i *excel.AuthorityInfo, _ int) string {
 			return i.Name
@@ -78,6 +80,14 @@ func (s *Service) authorityInfoHandler(c *gin.Context) {
 
 		return nil
 	})
+	if err != nil {
+		s.logger.Error("failed to save imported authority info in database", "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	// The new slots might be awaited by the business users on the waitlist
+	s.waitlist.Notify()
 }
 
 func (s *Service) listAuthoritiesHandler(c *gin.Context) {
@@ -90,8 +100,10 @@ func (s *Service) listAuthoritiesHandler(c *gin.Context) {
 
 	c.JSON(http.StatusOK, lo.Map(authorities, func(a storage.Authority, _ int) authority {
 		return authority{
-			ID:   a.ID,
-			Name: a.Name,
+			ID:                 a.ID,
+			Name:               a.Name,
+			RequireTwoFactor:   a.RequireTwoFactor,
+			AssignmentStrategy: string(a.AssignmentStrategy),
 		}
 	}))
 }
@@ -105,8 +117,8 @@ func (s *Service) createInspectorHandler(c *gin.Context) {
 	if req.Email == "" {
 		c.AbortWithStatusJSON(http.StatusBadRequest, apiError{"Необходимо указать почту для регистрации инспектора"})
 		return
-	} else if len(req.Password) < 3 || len(req.Password) > 50 {
-		c.AbortWithStatusJSON(http.StatusBadRequest, apiError{"Пароль инспектора должен быть не короче 3 и не длиннее 50 символов"})
+	} else if err := s.passwords.Validate(req.Password); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, apiError{err.Error()})
 		return
 	} else if req.FirstName == "" {
 		c.AbortWithStatusJSON(http.StatusBadRequest, apiError{"Необходимо указать имя инспектора"})
@@ -116,6 +128,16 @@ func (s *Service) createInspectorHandler(c *gin.Context) {
 		return
 	}
 
+	role := storage.AccountRole(req.Role)
+	if role == "" {
+		role = storage.AccountRoleInspector
+	}
+
+	if !lo.Contains(storage.AccountTypeRoles[storage.AccountTypeAuthority], role) {
+		c.AbortWithStatusJSON(http.StatusBadRequest, apiError{"Указана неизвестная роль инспектора"})
+		return
+	}
+
 	authorityID, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
 		c.AbortWithStatus(http.StatusBadRequest)
@@ -129,7 +151,7 @@ func (s *Service) createInspectorHandler(c *gin.Context) {
 		return
 	}
 
-	if err := s.db.CreateInspectorUser(c, req.Email, passwordHash, storage.InspectorUser{
+	if err := s.db.CreateInspectorUser(c, req.Email, passwordHash, role, storage.InspectorUser{
 		AuthorityID: authorityID,
 		FirstName:   req.FirstName,
 		LastName:    req.LastName,
@@ -141,3 +163,57 @@ func (s *Service) createInspectorHandler(c *gin.Context) {
 
 	c.Status(http.StatusCreated)
 }
+
+func (s *Service) authorityTwoFactorHandler(c *gin.Context) {
+	var req authorityTwoFactorRequest
+	if err := c.Bind(&req); err != nil {
+		return
+	}
+
+	authorityID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	if err := s.db.SetAuthorityTwoFactorRequired(c, authorityID, req.Required); errors.Is(err, storage.ErrNotFound) {
+		c.AbortWithStatusJSON(http.StatusNotFound, apiError{"Ведомство не найдено"})
+		return
+	} else if err != nil {
+		s.logger.Error("failed to update authority two-factor requirement in database", "authority_id", authorityID, "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (s *Service) authorityAssignmentStrategyHandler(c *gin.Context) {
+	var req authorityAssignmentStrategyRequest
+	if err := c.Bind(&req); err != nil {
+		return
+	}
+
+	authorityID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	strategy := storage.AssignmentStrategy(req.Strategy)
+	if !assignment.Valid(strategy) {
+		c.AbortWithStatusJSON(http.StatusBadRequest, apiError{"Неизвестная стратегия назначения инспекторов"})
+		return
+	}
+
+	if err := s.db.SetAuthorityAssignmentStrategy(c, authorityID, strategy); errors.Is(err, storage.ErrNotFound) {
+		c.AbortWithStatusJSON(http.StatusNotFound, apiError{"Ведомство не найдено"})
+		return
+	} else if err != nil {
+		s.logger.Error("failed to update authority assignment strategy in database", "authority_id", authorityID, "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
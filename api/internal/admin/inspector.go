@@ -0,0 +1,260 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"ldt-hack/api/internal/storage"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+const scheduleTimeLayout = "15:04"
+
+var inspectorAbsenceKinds = []storage.InspectorAbsenceKind{
+	storage.InspectorAbsenceKindVacation,
+	storage.InspectorAbsenceKindSickLeave,
+	storage.InspectorAbsenceKindOther,
+}
+
+func (s *Service) listInspectorsHandler(c *gin.Context) {
+	authorityID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	inspectors, err := s.db.ListAuthorityInspectors(c, authorityID)
+	if err != nil {
+		s.logger.Error("failed to list inspectors in database", "authority_id", authorityID, "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.JSON(http.StatusOK, lo.Map(inspectors, func(i storage.InspectorUser, _ int) inspector {
+		return inspector{
+			ID:        i.ID,
+			FirstName: i.FirstName,
+			LastName:  i.LastName,
+		}
+	}))
+}
+
+func (s *Service) getInspectorScheduleHandler(c *gin.Context) {
+	inspectorID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	schedule, err := s.db.GetInspectorSchedule(c, inspectorID)
+	if err != nil {
+		s.logger.Error("failed to get inspector schedule from database", "inspector_id", inspectorID, "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.JSON(http.StatusOK, lo.Map(schedule, func(e storage.InspectorSchedule, _ int) scheduleEntry {
+		return scheduleEntry{
+			Weekday:  e.Weekday,
+			FromTime: e.FromTime,
+			ToTime:   e.ToTime,
+		}
+	}))
+}
+
+func (s *Service) setInspectorScheduleHandler(c *gin.Context) {
+	var req setInspectorScheduleRequest
+	if err := c.BindJSON(&req); err != nil {
+		return
+	}
+
+	inspectorID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	schedule := make([]storage.InspectorSchedule, 0, len(req.Schedule))
+	for _, entry := range req.Schedule {
+		from, fromErr := time.Parse(scheduleTimeLayout, entry.FromTime)
+		to, toErr := time.Parse(scheduleTimeLayout, entry.ToTime)
+		if entry.Weekday < 1 || entry.Weekday > 7 || fromErr != nil || toErr != nil || !to.After(from) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, apiError{"Указаны некорректные часы работы инспектора"})
+			return
+		}
+
+		schedule = append(schedule, storage.InspectorSchedule{
+			Weekday:  entry.Weekday,
+			FromTime: entry.FromTime,
+			ToTime:   entry.ToTime,
+		})
+	}
+
+	if err := s.db.SetInspectorSchedule(c, inspectorID, schedule); errors.Is(err, storage.ErrNotFound) {
+		c.AbortWithStatusJSON(http.StatusNotFound, apiError{"Инспектор не найден"})
+		return
+	} else if err != nil {
+		s.logger.Error("failed to set inspector schedule in database", "inspector_id", inspectorID, "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	// The inspector might now be on duty during the slots awaited by the business users on the waitlist
+	s.waitlist.Notify()
+	c.Status(http.StatusOK)
+}
+
+func (s *Service) listInspectorAbsencesHandler(c *gin.Context) {
+	inspectorID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	absences, err := s.db.ListInspectorAbsences(c, inspectorID)
+	if err != nil {
+		s.logger.Error("failed to list inspector absences in database", "inspector_id", inspectorID, "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.JSON(http.StatusOK, lo.Map(absences, func(a storage.InspectorAbsence, _ int) inspectorAbsence {
+		return inspectorAbsence{
+			ID:       a.ID,
+			Kind:     string(a.Kind),
+			FromTime: a.FromTime,
+			ToTime:   a.ToTime,
+			Comment:  a.Comment,
+		}
+	}))
+}
+
+func (s *Service) createInspectorAbsenceHandler(c *gin.Context) {
+	var req createInspectorAbsenceRequest
+	if err := c.Bind(&req); err != nil {
+		return
+	}
+
+	kind := storage.InspectorAbsenceKind(req.Kind)
+	if !lo.Contains(inspectorAbsenceKinds, kind) {
+		c.AbortWithStatusJSON(http.StatusBadRequest, apiError{"Указан неизвестный тип отсутствия"})
+		return
+	} else if !req.ToTime.After(req.FromTime) {
+		c.AbortWithStatusJSON(http.StatusBadRequest, apiError{"Окончание отсутствия должно быть позже его начала"})
+		return
+	}
+
+	inspectorID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	id, err := s.db.CreateInspectorAbsence(c, storage.InspectorAbsence{
+		InspectorUserID: inspectorID,
+		Kind:            kind,
+		FromTime:        req.FromTime,
+		ToTime:          req.ToTime,
+		Comment:         req.Comment,
+	})
+	if errors.Is(err, storage.ErrNotFound) {
+		c.AbortWithStatusJSON(http.StatusNotFound, apiError{"Инспектор не найден"})
+		return
+	} else if err != nil {
+		s.logger.Error("failed to create inspector absence in database", "inspector_id", inspectorID, "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	s.waitlist.Notify()
+
+	c.JSON(http.StatusCreated, gin.H{"id": id})
+}
+
+func (s *Service) deleteInspectorAbsenceHandler(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	if err := s.db.DeleteInspectorAbsence(c, id); errors.Is(err, storage.ErrNotFound) {
+		c.AbortWithStatusJSON(http.StatusNotFound, apiError{"Отсутствие не найдено"})
+		return
+	} else if err != nil {
+		s.logger.Error("failed to delete inspector absence in database", "absence_id", id, "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	// The inspector is available again during the absence
+	s.waitlist.Notify()
+
+	c.Status(http.StatusOK)
+}
+
+func (s *Service) listAuthorityTopicsHandler(c *gin.Context) {
+	authorityID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	topics, err := s.db.ListAuthorityTopics(c, authorityID)
+	if err != nil {
+		s.logger.Error("failed to list authority topics in database", "authority_id", authorityID, "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.JSON(http.StatusOK, lo.Map(topics, func(t storage.ConsultationTopic, _ int) topic {
+		return topic{ID: t.ID, Name: t.Name}
+	}))
+}
+
+func (s *Service) getInspectorTopicsHandler(c *gin.Context) {
+	inspectorID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	topics, err := s.db.GetInspectorTopics(c, inspectorID)
+	if err != nil {
+		s.logger.Error("failed to get inspector topics from database", "inspector_id", inspectorID, "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.JSON(http.StatusOK, lo.Map(topics, func(t storage.ConsultationTopic, _ int) topic {
+		return topic{ID: t.ID, Name: t.Name}
+	}))
+}
+
+func (s *Service) setInspectorTopicsHandler(c *gin.Context) {
+	var req setInspectorTopicsRequest
+	if err := c.BindJSON(&req); err != nil {
+		return
+	}
+
+	inspectorID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	if err := s.db.SetInspectorTopics(c, inspectorID, req.TopicIDs); errors.Is(err, storage.ErrNotFound) {
+		c.AbortWithStatusJSON(http.StatusNotFound, apiError{"Инспектор или темы его ведомства не найдены"})
+		return
+	} else if err != nil {
+		s.logger.Error("failed to set inspector topics in database", "inspector_id", inspectorID, "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
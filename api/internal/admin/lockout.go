@@ -0,0 +1,55 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"ldt-hack/api/internal/storage"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+// Lockouts older than this aren't listed
+const lockoutListPeriod = time.Hour * 24 * 7
+
+func (s *Service) listLockoutsHandler(c *gin.Context) {
+	lockouts, err := s.db.ListLoginLockouts(c, time.Now().Add(-lockoutListPeriod))
+	if err != nil {
+		s.logger.Error("failed to list login lockouts in database", "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.JSON(http.StatusOK, lo.Map(lockouts, func(l storage.LoginLockout, _ int) lockout {
+		return lockout{
+			ID:          l.ID,
+			Key:         l.Key,
+			Failures:    l.Failures,
+			LockedAt:    l.LockedAt,
+			LockedUntil: l.LockedUntil,
+			ClearedAt:   l.ClearedAt,
+		}
+	}))
+}
+
+func (s *Service) clearLockoutHandler(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	if err := s.db.ClearLoginLockout(c, id); errors.Is(err, storage.ErrNotFound) {
+		c.AbortWithStatusJSON(http.StatusNotFound, apiError{"Блокировка не найдена или уже не действует"})
+		return
+	} else if err != nil {
+		s.logger.Error("failed to clear login lockout in database", "id", id, "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
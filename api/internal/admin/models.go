@@ -2,13 +2,22 @@ package admin
 
 import (
 	"mime/multipart"
+	"time"
 
 	"github.com/go-jose/go-jose/v3/jwt"
 )
 
 // session is the struct which is incoded in the admin's session
 type session struct {
-	Username string           `json:"username"`
+	// ID identifies the session across renewals, so that it can be revoked on logout
+	ID       string `json:"sid"`
+	AdminID  int64  `json:"admin_id"`
+	Username string `json:"username"`
+	// CSRFToken must be passed in the CSRF header of state-changing requests
+	CSRFToken string `json:"csrf"`
+	// AuthTime is the time of the login, after which the session can only be renewed for a limited period
+	AuthTime *jwt.NumericDate `json:"auth_time"`
+	IssuedAt *jwt.NumericDate `json:"iat"`
 	Expiry   *jwt.NumericDate `json:"exp"`
 }
 
@@ -28,6 +37,82 @@ type createInspectorRequest struct {
 	LastName  string `form:"last_name" binding:"required"`
 	Email     string `form:"email" binding:"required"`
 	Password  string `form:"password" binding:"required"`
+	Role      string `form:"role"`
+}
+
+type authorityTwoFactorRequest struct {
+	Required bool `form:"required"`
+}
+
+type authorityAssignmentStrategyRequest struct {
+	Strategy string `form:"strategy" binding:"required"`
+}
+
+type accountRoleRequest struct {
+	Email string `form:"email" binding:"required"`
+	Role  string `form:"role" binding:"required"`
+}
+
+type createAdminRequest struct {
+	Username string `form:"username" binding:"required"`
+	Password string `form:"password" binding:"required"`
+	Role     string `form:"role" binding:"required"`
+}
+
+type createAPIKeyRequest struct {
+	Name  string `form:"name" binding:"required"`
+	Scope string `form:"scope" binding:"required"`
+	// Keys without an expiry time never expire
+	ExpiresAt *time.Time `form:"expires_at"`
+}
+
+type scheduleEntry struct {
+	// ISO day of the week, 1 is Monday
+	Weekday int `json:"weekday"`
+	// Working hours in the "15:04" format
+	FromTime string `json:"from_time"`
+	ToTime   string `json:"to_time"`
+}
+
+// The whole schedule is replaced, an empty one means that the inspector is always on duty
+type setInspectorScheduleRequest struct {
+	Schedule []scheduleEntry `json:"schedule"`
+}
+
+// The topics are replaced, an empty list means that the inspector can handle all of the authority's topics
+type setInspectorTopicsRequest struct {
+	TopicIDs []int64 `json:"topic_ids"`
+}
+
+type createInspectorAbsenceRequest struct {
+	Kind     string    `form:"kind" binding:"required"`
+	FromTime time.Time `form:"from_time" binding:"required"`
+	ToTime   time.Time `form:"to_time" binding:"required"`
+	Comment  string    `form:"comment"`
+}
+
+type createSlotTemplateRequest struct {
+	// ISO day of the week, 1 is Monday
+	Weekday int `form:"weekday" binding:"required"`
+	// Time window in the "15:04" format which is split into slots
+	FromTime    string `form:"from_time" binding:"required"`
+	ToTime      string `form:"to_time" binding:"required"`
+	SlotMinutes int    `form:"slot_minutes" binding:"required"`
+	// Validity period in the "2006-01-02" format, templates without the end date are valid indefinitely
+	ValidFrom  string `form:"valid_from" binding:"required"`
+	ValidUntil string `form:"valid_until"`
+}
+
+type createSlotExceptionRequest struct {
+	Date    string `form:"date" binding:"required"`
+	Comment string `form:"comment"`
+}
+
+// Only the specified fields of the admin are updated
+type updateAdminRequest struct {
+	Password *string `form:"password"`
+	Role     *string `form:"role"`
+	Disabled *bool   `form:"disabled"`
 }
 
 // Responses
@@ -36,7 +121,87 @@ type apiError struct {
 	Error string `json:"error"`
 }
 
+type loginResponse struct {
+	CSRFToken string `json:"csrf_token"`
+}
+
 type authority struct {
+	ID                 int64  `json:"id"`
+	Name               string `json:"name"`
+	RequireTwoFactor   bool   `json:"require_two_factor"`
+	AssignmentStrategy string `json:"assignment_strategy"`
+}
+
+type lockout struct {
+	ID          int64      `json:"id"`
+	Key         string     `json:"key"`
+	Failures    int        `json:"failures"`
+	LockedAt    time.Time  `json:"locked_at"`
+	LockedUntil time.Time  `json:"locked_until"`
+	ClearedAt   *time.Time `json:"cleared_at"`
+}
+
+type adminUser struct {
+	ID          int64      `json:"id"`
+	Username    string     `json:"username"`
+	Role        string     `json:"role"`
+	Disabled    bool       `json:"disabled"`
+	CreatedAt   time.Time  `json:"created_at"`
+	LastLoginAt *time.Time `json:"last_login_at"`
+}
+
+type apiKey struct {
+	ID         int64      `json:"id"`
+	Name       string     `json:"name"`
+	Scope      string     `json:"scope"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+}
+
+// The key itself is only returned once, when it's created
+type createAPIKeyResponse struct {
+	ID  int64  `json:"id"`
+	Key string `json:"key"`
+}
+
+type inspector struct {
+	ID        int64  `json:"id"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+}
+
+type inspectorAbsence struct {
+	ID       int64     `json:"id"`
+	Kind     string    `json:"kind"`
+	FromTime time.Time `json:"from_time"`
+	ToTime   time.Time `json:"to_time"`
+	Comment  string    `json:"comment"`
+}
+
+type authoritySchedule struct {
+	Templates  []slotTemplate  `json:"templates"`
+	Exceptions []slotException `json:"exceptions"`
+}
+
+type slotTemplate struct {
+	ID          int64  `json:"id"`
+	Weekday     int    `json:"weekday"`
+	FromTime    string `json:"from_time"`
+	ToTime      string `json:"to_time"`
+	SlotMinutes int    `json:"slot_minutes"`
+	ValidFrom   string `json:"valid_from"`
+	ValidUntil  string `json:"valid_until,omitempty"`
+}
+
+type slotException struct {
+	ID      int64  `json:"id"`
+	Date    string `json:"date"`
+	Comment string `json:"comment"`
+}
+
+type topic struct {
 	ID   int64  `json:"id"`
 	Name string `json:"name"`
 }
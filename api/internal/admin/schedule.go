@@ -0,0 +1,211 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"ldt-hack/api/internal/storage"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+func (s *Service) getAuthorityScheduleHandler(c *gin.Context) {
+	authorityID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	templates, exceptions, err := s.db.GetAuthoritySlotSchedule(c, authorityID)
+	if err != nil {
+		s.logger.Error("failed to get authority slot schedule from database", "authority_id", authorityID, "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.JSON(http.StatusOK, authoritySchedule{
+		Templates: lo.Map(templates, func(t storage.SlotTemplate, _ int) slotTemplate {
+			var validUntil string
+			if t.ValidUntil != nil {
+				validUntil = t.ValidUntil.Format(time.DateOnly)
+			}
+
+			return slotTemplate{
+				ID:          t.ID,
+				Weekday:     t.Weekday,
+				FromTime:    t.FromTime,
+				ToTime:      t.ToTime,
+				SlotMinutes: t.SlotMinutes,
+				ValidFrom:   t.ValidFrom.Format(time.DateOnly),
+				ValidUntil:  validUntil,
+			}
+		}),
+		Exceptions: lo.Map(exceptions, func(e storage.SlotException, _ int) slotException {
+			return slotException{
+				ID:      e.ID,
+				Date:    e.Date.Format(time.DateOnly),
+				Comment: e.Comment,
+			}
+		}),
+	})
+}
+
+func (s *Service) createSlotTemplateHandler(c *gin.Context) {
+	var req createSlotTemplateRequest
+	if err := c.Bind(&req); err != nil {
+		return
+	}
+
+	authorityID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	from, fromErr := time.Parse(scheduleTimeLayout, req.FromTime)
+	to, toErr := time.Parse(scheduleTimeLayout, req.ToTime)
+	if req.Weekday < 1 || req.Weekday > 7 || fromErr != nil || toErr != nil || !to.After(from) {
+		c.AbortWithStatusJSON(http.StatusBadRequest, apiError{"Указано некорректное время приема"})
+		return
+	} else if req.SlotMinutes <= 0 || time.Duration(req.SlotMinutes)*time.Minute > to.Sub(from) {
+		c.AbortWithStatusJSON(http.StatusBadRequest, apiError{"Длительность слота должна быть положительной и не превышать время приема"})
+		return
+	}
+
+	validFrom, err := time.Parse(time.DateOnly, req.ValidFrom)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, apiError{"Указана некорректная дата начала действия расписания"})
+		return
+	}
+
+	var validUntil *time.Time
+	if req.ValidUntil != "" {
+		until, err := time.Parse(time.DateOnly, req.ValidUntil)
+		if err != nil || until.Before(validFrom) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, apiError{"Указана некорректная дата окончания действия расписания"})
+			return
+		}
+
+		validUntil = &until
+	}
+
+	id, err := s.db.CreateSlotTemplate(c, storage.SlotTemplate{
+		AuthorityID: authorityID,
+		Weekday:     req.Weekday,
+		FromTime:    req.FromTime,
+		ToTime:      req.ToTime,
+		SlotMinutes: req.SlotMinutes,
+		ValidFrom:   validFrom,
+		ValidUntil:  validUntil,
+	})
+	if err != nil {
+		s.logger.Error("failed to create slot template in database", "authority_id", authorityID, "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	s.regenerateSlots(c, authorityID)
+	c.JSON(http.StatusCreated, gin.H{"id": id})
+}
+
+func (s *Service) deleteSlotTemplateHandler(c *gin.Context) {
+	authorityID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("template_id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	if err := s.db.DeleteSlotTemplate(c, authorityID, id); errors.Is(err, storage.ErrNotFound) {
+		c.AbortWithStatusJSON(http.StatusNotFound, apiError{"Шаблон расписания не найден"})
+		return
+	} else if err != nil {
+		s.logger.Error("failed to delete slot template in database", "authority_id", authorityID, "template_id", id, "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (s *Service) createSlotExceptionHandler(c *gin.Context) {
+	var req createSlotExceptionRequest
+	if err := c.Bind(&req); err != nil {
+		return
+	}
+
+	authorityID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	date, err := time.Parse(time.DateOnly, req.Date)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, apiError{"Указана некорректная дата исключения"})
+		return
+	}
+
+	id, err := s.db.CreateSlotException(c, storage.SlotException{
+		AuthorityID: authorityID,
+		Date:        date,
+		Comment:     req.Comment,
+	})
+	if errors.Is(err, storage.ErrAlreadyExists) {
+		c.AbortWithStatusJSON(http.StatusConflict, apiError{"Исключение на эту дату уже существует"})
+		return
+	} else if err != nil {
+		s.logger.Error("failed to create slot exception in database", "authority_id", authorityID, "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	s.regenerateSlots(c, authorityID)
+	c.JSON(http.StatusCreated, gin.H{"id": id})
+}
+
+func (s *Service) deleteSlotExceptionHandler(c *gin.Context) {
+	authorityID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("exception_id"), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	if err := s.db.DeleteSlotException(c, authorityID, id); errors.Is(err, storage.ErrNotFound) {
+		c.AbortWithStatusJSON(http.StatusNotFound, apiError{"Исключение не найдено"})
+		return
+	} else if err != nil {
+		s.logger.Error("failed to delete slot exception in database", "authority_id", authorityID, "exception_id", id, "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	s.regenerateSlots(c, authorityID)
+	c.Status(http.StatusOK)
+}
+
+// regenerateSlots applies the changes of the authority's schedule immediately instead of waiting for the next generation.
+// Failures aren't reported to the admin since the schedule itself has been saved and will be applied later anyway.
+func (s *Service) regenerateSlots(ctx context.Context, authorityID int64) {
+	if err := s.slots.Generate(ctx, authorityID); err != nil {
+		s.logger.Error("failed to regenerate authority slots after schedule change", "authority_id", authorityID, "error", err)
+		return
+	}
+
+	s.waitlist.Notify()
+}
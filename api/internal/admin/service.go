@@ -1,49 +1,134 @@
 package admin
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"net/http"
 	"strings"
+	"time"
 
 	"ldt-hack/api/internal/auth"
+	"ldt-hack/api/internal/crypto"
+	"ldt-hack/api/internal/passwords"
+	"ldt-hack/api/internal/slots"
 	"ldt-hack/api/internal/storage"
+	"ldt-hack/api/internal/waitlist"
 
 	"github.com/gin-gonic/gin"
 	"golang.org/x/exp/slog"
 )
 
+// SessionOptions configure the admin sessions and the cookies in which they are stored.
+type SessionOptions struct {
+	// Secure cookies are only sent over HTTPS
+	Secure bool
+	// SameSite attribute of the cookies: strict, lax or none
+	SameSite string
+	// Sessions expire after this period of inactivity
+	IdleTimeout time.Duration
+	// Sessions can't be renewed for longer than this period after logging in
+	MaxAge time.Duration
+
+	sameSite http.SameSite
+}
+
 // Service implements the admin web server logic.
 type Service struct {
-	adminUsername     []byte
-	adminPasswordHash []byte
+	// Hash which is checked against when logging in as an unknown admin,
+	// so that existing usernames can't be found out by measuring the response time
+	dummyPasswordHash []byte
 
 	logger     *slog.Logger
 	db         *storage.Database
 	authorizer *auth.Authorizer
+	passwords  *passwords.Policy
+	sessions   SessionOptions
+	slots      *slots.Generator
+	waitlist   *waitlist.Processor
 }
 
-func NewService(logger *slog.Logger, db *storage.Database, authorizer *auth.Authorizer, adminCredentials string) (*Service, error) {
-	credentials := strings.Split(adminCredentials, ":")
-	if len(credentials) != 2 || credentials[0] == "" || credentials[1] == "" {
-		return nil, errors.New("invalid admin credentials provided")
+func NewService(logger *slog.Logger, db *storage.Database, authorizer *auth.Authorizer, passwordPolicy *passwords.Policy,
+	sessionOptions SessionOptions, slotGenerator *slots.Generator, waitlistProcessor *waitlist.Processor,
+) (*Service, error) {
+	switch strings.ToLower(sessionOptions.SameSite) {
+	case "strict":
+		sessionOptions.sameSite = http.SameSiteStrictMode
+	case "lax":
+		sessionOptions.sameSite = http.SameSiteLaxMode
+	case "none":
+		// Browsers reject SameSite=None cookies without the Secure attribute
+		if !sessionOptions.Secure {
+			return nil, errors.New("SameSite=None admin cookies must be secure")
+		}
+
+		sessionOptions.sameSite = http.SameSiteNoneMode
+	default:
+		return nil, fmt.Errorf("unknown SameSite cookie attribute %q", sessionOptions.SameSite)
+	}
+
+	if sessionOptions.IdleTimeout <= 0 || sessionOptions.MaxAge < sessionOptions.IdleTimeout {
+		return nil, fmt.Errorf("invalid admin session idle timeout %s and max age %s",
+			sessionOptions.IdleTimeout, sessionOptions.MaxAge)
+	}
+
+	dummyPasswordHash, err := crypto.HashPassword("dummy")
+	if err != nil {
+		return nil, fmt.Errorf("hashing dummy password: %w", err)
 	}
 
 	return &Service{
-		adminUsername:     []byte(credentials[0]),
-		adminPasswordHash: []byte(credentials[1]),
+		dummyPasswordHash: dummyPasswordHash,
 		logger:            logger.With("component", "admin"),
 		db:                db,
 		authorizer:        authorizer,
+		passwords:         passwordPolicy,
+		sessions:          sessionOptions,
+		slots:             slotGenerator,
+		waitlist:          waitlistProcessor,
 	}, nil
 }
 
+// SeedSuperAdmin creates the first super admin using the "username:password_hash" credentials
+// if no admins exist yet. Nothing is done if the credentials are empty.
+func (s *Service) SeedSuperAdmin(ctx context.Context, credentials string) error {
+	if credentials == "" {
+		return nil
+	}
+
+	username, passwordHash, ok := strings.Cut(credentials, ":")
+	if !ok || username == "" || passwordHash == "" {
+		return errors.New("invalid admin credentials provided")
+	}
+
+	created, err := s.db.SeedAdminUser(ctx, storage.AdminUser{
+		Username:     username,
+		PasswordHash: []byte(passwordHash),
+		Role:         storage.AdminRoleSuperAdmin,
+	})
+	if err != nil {
+		return fmt.Errorf("seeding super admin: %w", err)
+	} else if created {
+		s.logger.Info("seeded super admin from credentials", "username", username)
+	}
+
+	return nil
+}
+
 func (s *Service) RegisterRoutes(group *gin.RouterGroup) {
 	group.Use(func(c *gin.Context) {
 		c.Next()
 
+		var adminUsername string
+		if admin, ok := adminFromCtx(c); ok {
+			adminUsername = admin.Username
+		}
+
 		s.logger.Info("handling http request",
 			"method", c.Request.Method,
 			"path", c.FullPath(),
 			"status_code", c.Writer.Status(),
+			"admin", adminUsername,
 		)
 	})
 
@@ -54,15 +139,56 @@ func (s *Service) RegisterRoutes(group *gin.RouterGroup) {
 	// Authorized endpoints
 	authorized := group.Group("/")
 	authorized.Use(func(c *gin.Context) {
-		if !s.authorizeSession(c) {
+		admin, ok := s.authorizeSession(c)
+		if !ok {
+			return
+		}
+
+		// Viewers can only use the read-only endpoints
+		if admin.Role == storage.AdminRoleViewer && c.Request.Method != http.MethodGet {
+			c.AbortWithStatus(http.StatusForbidden)
 			return
 		}
 
 		c.Next()
 	})
 	{
+		authorized.POST("/logout", s.logoutHandler)
 		authorized.GET("/authority", s.listAuthoritiesHandler)
 		authorized.POST("/authority/info", s.authorityInfoHandler)
+		authorized.GET("/authority/:id/inspector", s.listInspectorsHandler)
 		authorized.POST("/authority/:id/inspector", s.createInspectorHandler)
+		authorized.GET("/authority/:id/topic", s.listAuthorityTopicsHandler)
+		authorized.GET("/authority/:id/schedule", s.getAuthorityScheduleHandler)
+		authorized.POST("/authority/:id/schedule/templates", s.createSlotTemplateHandler)
+		authorized.DELETE("/authority/:id/schedule/templates/:template_id", s.deleteSlotTemplateHandler)
+		authorized.POST("/authority/:id/schedule/exceptions", s.createSlotExceptionHandler)
+		authorized.DELETE("/authority/:id/schedule/exceptions/:exception_id", s.deleteSlotExceptionHandler)
+		authorized.GET("/inspector/:id/schedule", s.getInspectorScheduleHandler)
+		authorized.PUT("/inspector/:id/schedule", s.setInspectorScheduleHandler)
+		authorized.GET("/inspector/:id/topics", s.getInspectorTopicsHandler)
+		authorized.PUT("/inspector/:id/topics", s.setInspectorTopicsHandler)
+		authorized.GET("/inspector/:id/absences", s.listInspectorAbsencesHandler)
+		authorized.POST("/inspector/:id/absences", s.createInspectorAbsenceHandler)
+		authorized.DELETE("/absences/:id", s.deleteInspectorAbsenceHandler)
+		authorized.POST("/authority/:id/two-factor", s.authorityTwoFactorHandler)
+		authorized.POST("/authority/:id/assignment-strategy", s.authorityAssignmentStrategyHandler)
+		authorized.GET("/authority/:id/api-keys", s.listAPIKeysHandler)
+		authorized.POST("/authority/:id/api-keys", s.createAPIKeyHandler)
+		authorized.DELETE("/api-keys/:id", s.revokeAPIKeyHandler)
+		authorized.POST("/account/role", s.accountRoleHandler)
+		authorized.GET("/lockouts", s.listLockoutsHandler)
+		authorized.POST("/lockouts/:id/clear", s.clearLockoutHandler)
+	}
+
+	// Admin management endpoints
+	admins := authorized.Group("/admins")
+	admins.Use(requireAdminRole(storage.AdminRoleSuperAdmin))
+	{
+		admins.GET("", s.listAdminsHandler)
+		admins.POST("", s.createAdminHandler)
+		admins.GET("/:id", s.getAdminHandler)
+		admins.PUT("/:id", s.updateAdminHandler)
+		admins.DELETE("/:id", s.deleteAdminHandler)
 	}
 }
@@ -2,18 +2,28 @@ package admin
 
 import (
 	"crypto/subtle"
+	"errors"
+	"fmt"
 	"net/http"
 	"time"
 
 	"ldt-hack/api/internal/crypto"
+	"ldt-hack/api/internal/storage"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/samber/lo"
 )
 
 const (
 	sessionCookieName = "session"
-	sessionExpiry     = time.Hour
+	// Cookie which allows the admin panel to read the CSRF token for the session
+	csrfCookieName = "csrf_token"
+	csrfHeaderName = "X-CSRF-Token"
+	cookiePath     = "/admin"
+	// Keys under which the authorized admin and their session are stored in the gin context
+	adminContextKey   = "admin"
+	sessionContextKey = "admin_session"
 )
 
 func (s *Service) loginHandler(c *gin.Context) {
@@ -22,29 +32,126 @@ func (s *Service) loginHandler(c *gin.Context) {
 		return
 	}
 
-	usernameEqual := subtle.ConstantTimeCompare([]byte(req.Username), s.adminUsername) == 1
-	passwordEqual := crypto.ValidateHashedPassword(req.Password, s.adminPasswordHash)
-	if !usernameEqual || !passwordEqual {
+	throttleKeys := loginThrottleKeys(c, req.Username)
+	if !s.checkLoginThrottle(c, throttleKeys) {
+		return
+	}
+
+	admin, err := s.db.GetAdminUserByUsername(c, req.Username)
+	if errors.Is(err, storage.ErrNotFound) {
+		crypto.ValidateHashedPassword(req.Password, s.dummyPasswordHash)
+		s.recordLoginFailure(c, throttleKeys)
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	} else if err != nil {
+		s.logger.Error("failed to get admin from database", "username", req.Username, "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	if !crypto.ValidateHashedPassword(req.Password, admin.PasswordHash) || admin.Disabled {
+		s.recordLoginFailure(c, throttleKeys)
 		c.AbortWithStatus(http.StatusUnauthorized)
 		return
 	}
 
-	token, err := s.authorizer.Construct(session{
-		Username: req.Username,
-		Expiry:   jwt.NewNumericDate(time.Now().Add(sessionExpiry)),
+	s.resetLoginFailures(c, req.Username)
+
+	var newPasswordHash []byte
+	if crypto.PasswordNeedsRehash(admin.PasswordHash) {
+		if newPasswordHash, err = crypto.HashPassword(req.Password); err != nil {
+			s.logger.Error("failed to hash password", "operation", "rehash", "error", err)
+		}
+	}
+
+	if err := s.db.RecordAdminLogin(c, admin.ID, newPasswordHash); err != nil {
+		s.logger.Error("failed to record admin login in database", "admin_id", admin.ID, "error", err)
+	}
+
+	sessionID, err := crypto.GenerateToken()
+	if err != nil {
+		s.logger.Error("failed to generate admin session id", "admin_id", admin.ID, "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	sess, err := s.issueSession(c, session{
+		ID:       sessionID,
+		AdminID:  admin.ID,
+		Username: admin.Username,
+		AuthTime: jwt.NewNumericDate(time.Now()),
 	})
 	if err != nil {
-		s.logger.Error("failed to construct admin token", "error", err)
+		s.logger.Error("failed to issue admin session", "admin_id", admin.ID, "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.JSON(http.StatusOK, loginResponse{CSRFToken: sess.CSRFToken})
+}
+
+func (s *Service) logoutHandler(c *gin.Context) {
+	admin, _ := adminFromCtx(c)
+	sess, _ := sessionFromCtx(c)
+
+	// Only this session is signed out, the admin might still be using the panel elsewhere
+	if err := s.db.RevokeAdminSession(c, admin.ID, sess.ID, sess.AuthTime.Time().Add(s.sessions.MaxAge)); err != nil {
+		s.logger.Error("failed to revoke admin session in database", "admin_id", admin.ID, "error", err)
 		c.AbortWithStatus(http.StatusInternalServerError)
 		return
 	}
 
-	// Cookie without domain will be set for the origin by default
-	c.SetCookie(sessionCookieName, token, int(sessionExpiry.Seconds()), "/admin", "", false, true)
+	s.setCookie(c, sessionCookieName, "", -1, true)
+	s.setCookie(c, csrfCookieName, "", -1, false)
+	c.Status(http.StatusOK)
 }
 
-// authorizeSession tries to authorize a request using the session stored in the cookies and aborts the error otherwise
-func (s *Service) authorizeSession(c *gin.Context) (ok bool) {
+// issueSession constructs a new session token with a new expiry and sets the cookies for it. The CSRF token
+// is generated for new sessions, and kept when renewing them so that concurrent requests don't fail.
+// The session expires after the idle timeout passes, or at the max age after the login, whichever is earlier.
+func (s *Service) issueSession(c *gin.Context, sess session) (session, error) {
+	if sess.CSRFToken == "" {
+		csrfToken, err := crypto.GenerateToken()
+		if err != nil {
+			return session{}, fmt.Errorf("generating csrf token: %w", err)
+		}
+
+		sess.CSRFToken = csrfToken
+	}
+
+	now := time.Now()
+	expiry := now.Add(s.sessions.IdleTimeout)
+	if maxExpiry := sess.AuthTime.Time().Add(s.sessions.MaxAge); expiry.After(maxExpiry) {
+		expiry = maxExpiry
+	}
+
+	sess.IssuedAt = jwt.NewNumericDate(now)
+	sess.Expiry = jwt.NewNumericDate(expiry)
+
+	token, err := s.authorizer.Construct(sess)
+	if err != nil {
+		return session{}, fmt.Errorf("constructing admin token: %w", err)
+	}
+
+	maxAge := int(time.Until(expiry).Seconds())
+	s.setCookie(c, sessionCookieName, token, maxAge, true)
+	s.setCookie(c, csrfCookieName, sess.CSRFToken, maxAge, false)
+
+	return sess, nil
+}
+
+// setCookie sets an admin cookie with the configured attributes.
+// Cookie without domain will be set for the origin by default.
+func (s *Service) setCookie(c *gin.Context, name, value string, maxAge int, httpOnly bool) {
+	c.SetSameSite(s.sessions.sameSite)
+	c.SetCookie(name, value, maxAge, cookiePath, "", s.sessions.Secure, httpOnly)
+}
+
+// authorizeSession tries to authorize a request using the session stored in the cookies and aborts the error otherwise.
+// The admin is retrieved from the database so that disabled admins and role changes take effect immediately.
+// State-changing requests must pass the session's CSRF token in the CSRF header. Sessions which are close to
+// expiring are renewed so that they only expire after a period of inactivity.
+func (s *Service) authorizeSession(c *gin.Context) (admin storage.AdminUser, ok bool) {
 	defer func() {
 		if !ok {
 			c.AbortWithStatus(http.StatusForbidden)
@@ -53,13 +160,96 @@ func (s *Service) authorizeSession(c *gin.Context) (ok bool) {
 
 	sessionCookie, err := c.Cookie(sessionCookieName)
 	if err != nil {
-		return false
+		return storage.AdminUser{}, false
 	}
 
 	var sess session
-	if !s.authorizer.VerifyAndParse(sessionCookie, &sess) {
-		return false
+	if !s.authorizer.VerifyAndParse(sessionCookie, &sess) || sess.ID == "" || sess.AdminID == 0 ||
+		sess.AuthTime == nil || sess.IssuedAt == nil {
+		return storage.AdminUser{}, false
+	} else if !sess.Expiry.Time().After(time.Now()) {
+		return storage.AdminUser{}, false
+	}
+
+	if !safeMethod(c.Request.Method) &&
+		subtle.ConstantTimeCompare([]byte(c.GetHeader(csrfHeaderName)), []byte(sess.CSRFToken)) != 1 {
+		return storage.AdminUser{}, false
+	}
+
+	admin, err = s.db.GetAdminUser(c, sess.AdminID)
+	if errors.Is(err, storage.ErrNotFound) {
+		return storage.AdminUser{}, false
+	} else if err != nil {
+		s.logger.Error("failed to get session admin from database", "admin_id", sess.AdminID, "error", err)
+		return storage.AdminUser{}, false
+	} else if admin.Disabled {
+		return storage.AdminUser{}, false
+	} else if issuedBeforeRevocation(sess, admin) {
+		return storage.AdminUser{}, false
+	}
+
+	if revoked, err := s.db.IsAdminSessionRevoked(c, sess.ID); err != nil {
+		s.logger.Error("failed to check admin session revocation in database", "admin_id", admin.ID, "error", err)
+		return storage.AdminUser{}, false
+	} else if revoked {
+		return storage.AdminUser{}, false
+	}
+
+	// Renew the session once half of the idle timeout has passed
+	if time.Until(sess.Expiry.Time()) < s.sessions.IdleTimeout/2 &&
+		sess.AuthTime.Time().Add(s.sessions.MaxAge).After(sess.Expiry.Time()) {
+		if _, err := s.issueSession(c, sess); err != nil {
+			s.logger.Error("failed to renew admin session", "admin_id", admin.ID, "error", err)
+		}
+	}
+
+	c.Set(adminContextKey, admin)
+	c.Set(sessionContextKey, sess)
+	return admin, true
+}
+
+// issuedBeforeRevocation checks if the session has been issued before all of the admin's sessions were revoked. Tokens only
+// have a one-second precision, so the ones issued during the second of the revocation are treated as issued before it.
+func issuedBeforeRevocation(sess session, admin storage.AdminUser) bool {
+	return admin.SessionsValidAfter != nil && !sess.IssuedAt.Time().After(admin.SessionsValidAfter.Truncate(time.Second))
+}
+
+// safeMethod checks if the HTTP method doesn't change any state and thus doesn't require CSRF protection.
+func safeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+// adminFromCtx returns the admin authorized by authorizeSession.
+func adminFromCtx(c *gin.Context) (storage.AdminUser, bool) {
+	value, ok := c.Get(adminContextKey)
+	if !ok {
+		return storage.AdminUser{}, false
 	}
 
-	return sess.Expiry.Time().After(time.Now()) && sess.Username == string(s.adminUsername)
+	admin, ok := value.(storage.AdminUser)
+	return admin, ok
+}
+
+// sessionFromCtx returns the session authorized by authorizeSession.
+func sessionFromCtx(c *gin.Context) (session, bool) {
+	value, ok := c.Get(sessionContextKey)
+	if !ok {
+		return session{}, false
+	}
+
+	sess, ok := value.(session)
+	return sess, ok
+}
+
+// requireAdminRole returns a middleware which only allows admins with one of the roles to access the endpoints.
+func requireAdminRole(roles ...storage.AdminRole) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		admin, ok := adminFromCtx(c)
+		if !ok || !lo.Contains(roles, admin.Role) {
+			c.AbortWithStatus(http.StatusForbidden)
+			return
+		}
+
+		c.Next()
+	}
 }
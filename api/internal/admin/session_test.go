@@ -0,0 +1,40 @@
+package admin
+
+import (
+	"testing"
+	"time"
+
+	"ldt-hack/api/internal/storage"
+
+	"github.com/go-jose/go-jose/v3/jwt"
+)
+
+func TestIssuedBeforeRevocation(t *testing.T) {
+	revokedAt := time.Date(2023, 6, 1, 12, 0, 0, 500_000_000, time.UTC)
+
+	tests := []struct {
+		name     string
+		issuedAt time.Time
+		want     bool
+	}{
+		{"earlier second", revokedAt.Add(-time.Second), true},
+		{"same second before revocation", revokedAt.Add(-time.Millisecond * 100), true},
+		{"same second after revocation", revokedAt.Add(time.Millisecond * 100), true},
+		{"next second", revokedAt.Add(time.Second), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sess := session{IssuedAt: jwt.NewNumericDate(tt.issuedAt)}
+			admin := storage.AdminUser{SessionsValidAfter: &revokedAt}
+
+			if got := issuedBeforeRevocation(sess, admin); got != tt.want {
+				t.Errorf("issuedBeforeRevocation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if issuedBeforeRevocation(session{IssuedAt: jwt.NewNumericDate(revokedAt)}, storage.AdminUser{}) {
+		t.Error("session of an admin without revocations is rejected")
+	}
+}
@@ -0,0 +1,93 @@
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+const (
+	// Failed login attempts are forgotten after this interval passes without any new failures
+	loginFailureResetInterval = time.Hour
+	// There are only a few admins, so instead of slowing down the attempts they are locked out right away
+	// after a handful of failures, which is listed along with the lockouts of the users and can be cleared
+	usernameLockoutFailures = 5
+	// IPs are allowed more failures since the admins might share a single IP
+	ipLockoutFailures    = 20
+	loginLockoutDuration = time.Minute * 15
+)
+
+// loginThrottleKeys returns the keys by which the admin logins are throttled along with the number of failures
+// after which they are locked out. The keys are prefixed so that they never overlap with the keys of the app logins.
+func loginThrottleKeys(c *gin.Context, username string) map[string]int {
+	keys := map[string]int{
+		"admin:username:" + strings.ToLower(username): usernameLockoutFailures,
+	}
+
+	if ipAddress := c.ClientIP(); ipAddress != "" {
+		keys["admin:ip:"+ipAddress] = ipLockoutFailures
+	}
+
+	return keys
+}
+
+// checkLoginThrottle aborts the request with the retry delay if logging in is currently blocked for any of the keys.
+func (s *Service) checkLoginThrottle(c *gin.Context, keys map[string]int) bool {
+	blockedUntil, err := s.db.GetLoginBlockedUntil(c, lo.Keys(keys))
+	if err != nil {
+		s.logger.Error("failed to check admin login throttle in database", "keys", lo.Keys(keys), "error", err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return false
+	} else if blockedUntil == nil {
+		return true
+	}
+
+	retryAfter := retryAfterSeconds(time.Until(*blockedUntil))
+	c.Header("Retry-After", strconv.Itoa(retryAfter))
+	c.AbortWithStatusJSON(http.StatusTooManyRequests,
+		apiError{fmt.Sprintf("Слишком много неудачных попыток входа, повторите через %d с.", retryAfter)})
+	return false
+}
+
+// recordLoginFailure records a failed login attempt for all of the keys, locking them out if needed.
+func (s *Service) recordLoginFailure(c *gin.Context, keys map[string]int) {
+	for key, lockoutFailures := range keys {
+		failures, err := s.db.RecordLoginFailure(c, key, time.Now().Add(-loginFailureResetInterval))
+		if err != nil {
+			s.logger.Error("failed to record admin login failure in database", "key", key, "error", err)
+			continue
+		}
+
+		if failures >= lockoutFailures {
+			if err := s.db.LockoutLogin(c, key, failures, time.Now().Add(loginLockoutDuration)); err != nil {
+				s.logger.Error("failed to lockout admin login in database", "key", key, "error", err)
+			} else {
+				s.logger.Warn("admin login locked out due to too many failed attempts", "key", key, "failures", failures)
+			}
+		}
+	}
+}
+
+// resetLoginFailures forgets the previous failed login attempts for the username after a successful login.
+// IP failures aren't reset since a single valid admin would then allow bypassing the throttling.
+func (s *Service) resetLoginFailures(c *gin.Context, username string) {
+	key := "admin:username:" + strings.ToLower(username)
+	if err := s.db.ResetLoginFailures(c, key); err != nil {
+		s.logger.Error("failed to reset admin login failures in database", "key", key, "error", err)
+	}
+}
+
+// retryAfterSeconds rounds the retry delay to whole seconds, never returning less than a second.
+func retryAfterSeconds(retryDelay time.Duration) int {
+	retryDelay = retryDelay.Round(time.Second)
+	if retryDelay < time.Second {
+		return 1
+	}
+
+	return int(retryDelay.Seconds())
+}
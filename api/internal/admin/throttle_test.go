@@ -0,0 +1,58 @@
+package admin
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Admin logins must not lock out the app users with the same email or IP and vice versa, so the keys must never overlap.
+func TestLoginThrottleKeys(t *testing.T) {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest("POST", "/admin/login", nil)
+	c.Request.RemoteAddr = "192.0.2.1:4321"
+
+	keys := loginThrottleKeys(c, "Admin")
+
+	want := map[string]int{
+		"admin:username:admin": usernameLockoutFailures,
+		"admin:ip:192.0.2.1":   ipLockoutFailures,
+	}
+	if len(keys) != len(want) {
+		t.Errorf("keys = %v, want %v", keys, want)
+	}
+
+	for key, failures := range want {
+		if keys[key] != failures {
+			t.Errorf("key %s locks out after %d failures, want %d", key, keys[key], failures)
+		}
+	}
+
+	for key := range keys {
+		if !strings.HasPrefix(key, "admin:") {
+			t.Errorf("key %s can overlap with the app login keys", key)
+		}
+	}
+}
+
+func TestRetryAfterSeconds(t *testing.T) {
+	tests := []struct {
+		retryDelay time.Duration
+		want       int
+	}{
+		{-time.Second, 1},
+		{0, 1},
+		{time.Millisecond * 300, 1},
+		{time.Millisecond * 1500, 2},
+		{time.Minute * 15, 900},
+	}
+
+	for _, tt := range tests {
+		if got := retryAfterSeconds(tt.retryDelay); got != tt.want {
+			t.Errorf("retryAfterSeconds(%v) = %d, want %d", tt.retryDelay, got, tt.want)
+		}
+	}
+}
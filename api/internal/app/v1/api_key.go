@@ -0,0 +1,43 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ldt-hack/api/internal/crypto"
+	"ldt-hack/api/internal/storage"
+)
+
+// Minimum interval between updates of an API key's last used time
+const apiKeyTouchInterval = time.Minute
+
+// ResolveAPIKey resolves an API key into a session of its authority which has been granted the permissions of the key's scope.
+func (s *Service) ResolveAPIKey(ctx context.Context, key string) (Session, bool) {
+	apiKey, err := s.db.GetActiveAPIKey(ctx, crypto.HashToken(key))
+	if errors.Is(err, storage.ErrNotFound) {
+		return Session{}, false
+	} else if err != nil {
+		s.logger.Error("failed to get api key from db", "error", err)
+		return Session{}, false
+	}
+
+	permissions, ok := apiKeyScopePermissions[apiKey.Scope]
+	if !ok {
+		s.logger.Error("api key has unknown scope", "api_key_id", apiKey.ID, "scope", apiKey.Scope)
+		return Session{}, false
+	}
+
+	if apiKey.LastUsedAt == nil || time.Since(*apiKey.LastUsedAt) > apiKeyTouchInterval {
+		if err := s.db.TouchAPIKey(ctx, apiKey.ID); err != nil {
+			s.logger.Error("failed to update api key last used time in db", "api_key_id", apiKey.ID, "error", err)
+		}
+	}
+
+	return Session{
+		AccountType: storage.AccountTypeAuthority,
+		Permissions: permissions,
+		APIKeyID:    apiKey.ID,
+		AuthorityID: apiKey.AuthorityID,
+	}, true
+}
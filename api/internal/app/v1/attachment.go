@@ -0,0 +1,246 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"ldt-hack/api/internal/crypto"
+	desc "ldt-hack/api/internal/pb/app/v1"
+	"ldt-hack/api/internal/storage"
+
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// Maximum length of the question attached to an appointment
+	maxQuestionLength = 2000
+	// Limits of the files attached to an appointment, which must fit into a single gRPC message of at most 4 MB
+	maxAttachments         = 3
+	maxAttachmentSize      = 1 << 20
+	maxAttachmentNameBytes = 255
+
+	defaultAttachmentContentType = "application/octet-stream"
+
+	// Age after which the stored files without an attachment are deleted. The files of new appointments
+	// are stored before the appointments are created, so they are kept for a while before being considered orphaned.
+	orphanedBlobAge = time.Hour
+	// Maximum number of stored files checked against the attachments at once
+	blobSweepBatchSize = 1000
+)
+
+var (
+	errQuestionTooLong       = status.Error(codes.InvalidArgument, "Вопрос к консультации слишком длинный")
+	errTooManyAttachments    = status.Error(codes.InvalidArgument, "К консультации можно приложить не более 3 файлов")
+	errAttachmentTooLarge    = status.Error(codes.InvalidArgument, "Размер приложенного файла не должен превышать 1 МБ")
+	errAttachmentNameTooLong = status.Error(codes.InvalidArgument, "Имя приложенного файла слишком длинное")
+	errInvalidAttachment     = status.Error(codes.InvalidArgument, "Приложенный файл должен иметь имя и содержимое")
+	errAttachmentNotFound    = status.Error(codes.NotFound, "Выбран несуществующий файл")
+)
+
+// GetConsultationAttachment implements the consultation attachment download endpoint.
+// The attachments are available to the same users who can see their appointments in ListConsultationAppointments.
+func (s *Service) GetConsultationAttachment(ctx context.Context, req *desc.GetConsultationAttachmentRequest) (*desc.GetConsultationAttachmentResponse, error) {
+	session, authorized := s.authorizeSession(ctx)
+	if !authorized {
+		return nil, errUnauthorized
+	}
+
+	attachment, err := s.db.GetConsultationAttachment(ctx, req.Id)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, errAttachmentNotFound
+	} else if err != nil {
+		s.logger.Error("failed to get consultation attachment from storage", "attachment_id", req.Id, "error", err)
+		return nil, errInternal
+	}
+
+	if visible, err := s.canViewAppointment(ctx, session, attachment.Appointment); err != nil {
+		s.logger.Error("failed to check access to consultation attachment",
+			"attachment_id", req.Id,
+			"account_id", session.AccountID,
+			"error", err,
+		)
+		return nil, errInternal
+	} else if !visible {
+		return nil, errAttachmentNotFound
+	}
+
+	r, err := s.blobs.Get(ctx, attachment.BlobKey)
+	if err != nil {
+		s.logger.Error("failed to open consultation attachment blob",
+			"attachment_id", req.Id,
+			"blob_key", attachment.BlobKey,
+			"error", err,
+		)
+		return nil, errInternal
+	}
+	defer r.Close()
+
+	content, err := io.ReadAll(r)
+	if err != nil {
+		s.logger.Error("failed to read consultation attachment blob",
+			"attachment_id", req.Id,
+			"blob_key", attachment.BlobKey,
+			"error", err,
+		)
+		return nil, errInternal
+	}
+
+	return &desc.GetConsultationAttachmentResponse{
+		Name:        attachment.Name,
+		ContentType: attachment.ContentType,
+		Content:     content,
+	}, nil
+}
+
+// canViewAppointment checks whether the appointment is listed for the session in ListConsultationAppointments.
+func (s *Service) canViewAppointment(ctx context.Context, session Session, appointment *storage.ConsultationAppointment,
+) (bool, error) {
+	switch {
+	case appointment == nil:
+		return false, nil
+	case session.APIKeyID != 0:
+		return appointment.InspectorUser.AuthorityID == session.AuthorityID, nil
+	case session.AccountType == storage.AccountTypeBusiness:
+		return appointment.BusinessUser.AccountID == session.AccountID, nil
+	case session.HasPermission(PermissionViewAuthorityConsultations):
+		inspector, err := s.db.GetInspectorUser(ctx, session.AccountID)
+		if err != nil {
+			return false, err
+		}
+		return appointment.InspectorUser.AuthorityID == inspector.AuthorityID, nil
+	case session.AccountType == storage.AccountTypeAuthority:
+		return appointment.InspectorUser.AccountID == session.AccountID, nil
+	default:
+		return false, nil
+	}
+}
+
+// validateAppointmentDetails checks the question and the attachments of a new appointment.
+func validateAppointmentDetails(question string, uploads []*desc.ConsultationAttachmentUpload) error {
+	if utf8.RuneCountInString(question) > maxQuestionLength {
+		return errQuestionTooLong
+	} else if len(uploads) > maxAttachments {
+		return errTooManyAttachments
+	}
+
+	for _, upload := range uploads {
+		if attachmentName(upload.Name) == "" || len(upload.Content) == 0 {
+			return errInvalidAttachment
+		} else if len(attachmentName(upload.Name)) > maxAttachmentNameBytes {
+			return errAttachmentNameTooLong
+		} else if len(upload.Content) > maxAttachmentSize {
+			return errAttachmentTooLarge
+		}
+	}
+
+	return nil
+}
+
+// storeAttachments puts the contents of the uploaded files into the blob store, returning the attachments
+// to be saved with the appointment. Already stored blobs are deleted if one of the files can't be stored.
+func (s *Service) storeAttachments(ctx context.Context, uploads []*desc.ConsultationAttachmentUpload,
+) ([]storage.ConsultationAttachment, error) {
+	attachments := make([]storage.ConsultationAttachment, 0, len(uploads))
+
+	for _, upload := range uploads {
+		key, err := crypto.GenerateToken()
+		if err != nil {
+			s.deleteAttachmentBlobs(ctx, attachments)
+			return nil, err
+		}
+
+		if err := s.blobs.Put(ctx, key, bytes.NewReader(upload.Content)); err != nil {
+			s.deleteAttachmentBlobs(ctx, attachments)
+			return nil, err
+		}
+
+		contentType := strings.TrimSpace(upload.ContentType)
+		if contentType == "" {
+			contentType = defaultAttachmentContentType
+		}
+
+		attachments = append(attachments, storage.ConsultationAttachment{
+			Name:        attachmentName(upload.Name),
+			ContentType: contentType,
+			Size:        int64(len(upload.Content)),
+			BlobKey:     key,
+		})
+	}
+
+	return attachments, nil
+}
+
+// deleteAttachmentBlobs deletes the stored contents of the attachments which couldn't be saved.
+func (s *Service) deleteAttachmentBlobs(ctx context.Context, attachments []storage.ConsultationAttachment) {
+	for _, attachment := range attachments {
+		if err := s.blobs.Delete(ctx, attachment.BlobKey); err != nil {
+			s.logger.Error("failed to delete unused consultation attachment blob", "blob_key", attachment.BlobKey, "error", err)
+		}
+	}
+}
+
+// SweepAttachmentBlobs deletes the stored files which don't belong to any attachment at the interval until the context is canceled.
+// The attachments are deleted along with their appointments by the database, which leaves their files behind.
+func (s *Service) SweepAttachmentBlobs(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		deleted, err := s.sweepAttachmentBlobs(ctx)
+		if err != nil {
+			s.logger.Error("failed to delete orphaned consultation attachment blobs", "error", err)
+		}
+
+		if deleted > 0 {
+			s.logger.Debug("deleted orphaned consultation attachment blobs", "count", deleted)
+		}
+	}
+}
+
+func (s *Service) sweepAttachmentBlobs(ctx context.Context) (int, error) {
+	keys, err := s.blobs.List(ctx, time.Now().Add(-orphanedBlobAge))
+	if err != nil {
+		return 0, fmt.Errorf("listing blobs: %w", err)
+	}
+
+	deleted := 0
+	for _, batch := range lo.Chunk(keys, blobSweepBatchSize) {
+		existing, err := s.db.FilterAttachmentBlobKeys(ctx, batch)
+		if err != nil {
+			return deleted, fmt.Errorf("filtering attachment blob keys: %w", err)
+		}
+
+		for _, key := range lo.Without(batch, existing...) {
+			if err := s.blobs.Delete(ctx, key); err != nil {
+				return deleted, err
+			}
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
+
+// attachmentName strips the directories which some clients include in the names of the uploaded files.
+func attachmentName(name string) string {
+	name = strings.TrimSpace(filepath.Base(strings.ReplaceAll(name, `\`, "/")))
+	if name == "." || name == "/" {
+		return ""
+	}
+	return name
+}
@@ -0,0 +1,92 @@
+package app
+
+import (
+	"context"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"ldt-hack/api/internal/cache"
+	"ldt-hack/api/internal/storage"
+)
+
+// CacheOptions configure the caches of the accounts and sessions checked on each request.
+type CacheOptions struct {
+	// Maximum number of cached accounts and sessions each, caching is disabled if zero
+	Size int
+	// Time for which the entries are cached, limiting how stale they can get if an invalidation is missed
+	TTL time.Duration
+}
+
+// appCaches contain the data checked when authorizing each request, so that it doesn't have to be
+// queried from the database every time. Entries are invalidated through database notifications,
+// and the caches are only used while the notifications are being received.
+type appCaches struct {
+	// Types of existing accounts
+	accounts *cache.Cache[int64, storage.AccountType]
+	// Active sessions by their IDs
+	sessions *cache.Cache[string, storage.AccountSession]
+	// Set while listening for the invalidations, the cached entries can't be trusted otherwise
+	listening *atomic.Bool
+}
+
+func newAppCaches(options CacheOptions) appCaches {
+	return appCaches{
+		accounts:  cache.New[int64, storage.AccountType](options.Size, options.TTL),
+		sessions:  cache.New[string, storage.AccountSession](options.Size, options.TTL),
+		listening: new(atomic.Bool),
+	}
+}
+
+// account returns the cached type of the account, if caching is currently enabled.
+func (c appCaches) account(accountID int64) (storage.AccountType, bool) {
+	if !c.listening.Load() {
+		return "", false
+	}
+	return c.accounts.Get(accountID)
+}
+
+// session returns the cached session, if caching is currently enabled.
+func (c appCaches) session(sessionID string) (storage.AccountSession, bool) {
+	if !c.listening.Load() {
+		return storage.AccountSession{}, false
+	}
+	return c.sessions.Get(sessionID)
+}
+
+// clear removes all of the cached entries, including the ones being loaded right now.
+func (c appCaches) clear() {
+	c.accounts.Clear()
+	c.sessions.Clear()
+}
+
+// WatchInvalidations invalidates the cached accounts and sessions when they are deleted or revoked
+// by any of the API instances, until the context is canceled. Caching is disabled while the invalidations
+// can't be received, and the caches are cleared on reconnecting since the invalidations sent meanwhile are lost.
+func (s *Service) WatchInvalidations(ctx context.Context) {
+	defer s.caches.listening.Store(false)
+
+	s.db.ListenInvalidations(ctx, func(channel, payload string) {
+		switch channel {
+		case storage.AccountInvalidationChannel:
+			accountID, err := strconv.ParseInt(payload, 10, 64)
+			if err != nil {
+				s.logger.Error("received invalid account invalidation", "payload", payload)
+				s.caches.accounts.Clear()
+				return
+			}
+
+			s.caches.accounts.Delete(accountID)
+		case storage.SessionInvalidationChannel:
+			s.caches.sessions.Delete(payload)
+		}
+	}, func() {
+		s.logger.Debug("listening for cache invalidations, enabling caches")
+		s.caches.clear()
+		s.caches.listening.Store(true)
+	}, func(err error) {
+		s.logger.Warn("listening for cache invalidations failed, disabling caches until reconnected", "error", err)
+		s.caches.listening.Store(false)
+		s.caches.clear()
+	})
+}
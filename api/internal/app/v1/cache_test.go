@@ -0,0 +1,95 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"ldt-hack/api/internal/storage"
+
+	"golang.org/x/exp/slog"
+)
+
+// BenchmarkAuthorizeSession compares the checks made for each request of a session with and without caching.
+// It needs a database migrated to the latest version, whose DSN is passed in the TEST_POSTGRES_DSN environment variable.
+func BenchmarkAuthorizeSession(b *testing.B) {
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		b.Skip("TEST_POSTGRES_DSN isn't set")
+	}
+
+	ctx := context.Background()
+	db, err := storage.Open(ctx, dsn)
+	if err != nil {
+		b.Fatalf("opening database: %v", err)
+	}
+	b.Cleanup(func() { db.Close() })
+
+	email := fmt.Sprintf("benchmark-%d@example.com", time.Now().UnixNano())
+	accountID, err := db.CreateBusinessUser(ctx, email, []byte("hash"), storage.BusinessUser{
+		FirstName:    "Иван",
+		LastName:     "Иванов",
+		Sex:          storage.PersonSexMale,
+		BirthDate:    time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+		BusinessName: "ООО Тест",
+		PhoneNumber:  "+70000000000",
+	})
+	if err != nil {
+		b.Fatalf("creating business user: %v", err)
+	}
+	b.Cleanup(func() { db.DeleteAccount(ctx, accountID) })
+
+	sessionID, err := db.CreateSession(ctx, storage.AccountSession{
+		AccountID: accountID,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}, []byte(email))
+	if err != nil {
+		b.Fatalf("creating session: %v", err)
+	}
+
+	session := Session{TokenID: sessionID, AccountID: accountID, AccountType: storage.AccountTypeBusiness}
+
+	for _, bc := range []struct {
+		name    string
+		options CacheOptions
+	}{
+		{"Uncached", CacheOptions{}},
+		{"Cached", CacheOptions{Size: 1000, TTL: time.Minute}},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			s := &Service{
+				logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+				db:     db,
+				caches: newAppCaches(bc.options),
+			}
+			// As if the invalidations were being received
+			s.caches.listening.Store(true)
+
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					if !s.ValidateSession(ctx, session) || !s.checkSessionAccount(ctx, session) {
+						b.Fatal("valid session rejected")
+					}
+				}
+			})
+		})
+	}
+}
+
+func TestCachesDisabledWhileNotListening(t *testing.T) {
+	caches := newAppCaches(CacheOptions{Size: 10, TTL: time.Minute})
+	caches.accounts.Set(1, storage.AccountTypeBusiness)
+
+	if _, ok := caches.account(1); ok {
+		t.Error("cached account returned while invalidations aren't received")
+	}
+
+	caches.listening.Store(true)
+	if _, ok := caches.account(1); !ok {
+		t.Error("cached account not returned while invalidations are received")
+	}
+}
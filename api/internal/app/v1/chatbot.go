@@ -5,7 +5,6 @@ import (
 	"strconv"
 
 	desc "ldt-hack/api/internal/pb/app/v1"
-	"ldt-hack/api/internal/storage"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -14,7 +13,7 @@ import (
 
 // SendChatBotMessage implements the chat bot message endpoint.
 func (s *Service) SendChatBotMessage(ctx context.Context, req *desc.SendChatBotMessageRequest) (*desc.SendChatBotMessageResponse, error) {
-	session, authorized := s.authorizeSession(ctx, storage.AccountTypeBusiness)
+	session, authorized := s.authorizeSession(ctx)
 	if !authorized {
 		return nil, errUnauthorized
 	}
@@ -40,7 +39,7 @@ func (s *Service) SendChatBotMessage(ctx context.Context, req *desc.SendChatBotM
 
 // RateChatBot implements the chat bot rating message.
 func (s *Service) RateChatBot(ctx context.Context, req *desc.RateChatBotRequest) (*emptypb.Empty, error) {
-	_, authorized := s.authorizeSession(ctx, storage.AccountTypeBusiness)
+	_, authorized := s.authorizeSession(ctx)
 	if !authorized {
 		return nil, errUnauthorized
 	}
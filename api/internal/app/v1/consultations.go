@@ -4,11 +4,16 @@ import (
 	"context"
 	"errors"
 	"sort"
+	"strings"
 	"time"
+	"unicode/utf8"
 
+	"ldt-hack/api/internal/assignment"
+	"ldt-hack/api/internal/crypto"
 	desc "ldt-hack/api/internal/pb/app/v1"
 	"ldt-hack/api/internal/storage"
 
+	"github.com/asaskevich/govalidator"
 	"github.com/samber/lo"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -16,14 +21,23 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// Maximum length of the consultation cancelation reason
+const maxCancelReasonLength = 500
+
 var (
+	errCancelReasonRequired = status.Error(codes.InvalidArgument, "Необходимо указать причину отмены консультации")
+	errCancelReasonTooLong  = status.Error(codes.InvalidArgument, "Причина отмены консультации слишком длинная")
 	errSlotAlreadyTaken     = status.Error(codes.AlreadyExists, "Выбранное время консультации уже занял другой человек, выберите новое!")
 	errConsultationNotFound = status.Error(codes.NotFound, "Выбрана несуществующая консультация")
+	errTopicNotFound        = status.Error(codes.NotFound, "Выбрана несуществующая тема консультации")
+	errInvalidDateRange     = status.Error(codes.InvalidArgument, "Выбран некорректный диапазон дат")
+	errAlreadyWaitlisted    = status.Error(codes.AlreadyExists, "Вы уже ожидаете консультацию по этой теме")
+	errNotWaitlisted        = status.Error(codes.NotFound, "Вы не ожидаете консультацию по этой теме")
 )
 
 // ListConsultationTopics implements the consultation topic listing endpoint.
 func (s *Service) ListConsultationTopics(ctx context.Context, _ *emptypb.Empty) (*desc.ListConsultationTopicsResponse, error) {
-	if _, authorized := s.authorizeSession(ctx, storage.AccountTypeBusiness); !authorized {
+	if _, authorized := s.authorizeSession(ctx); !authorized {
 		return nil, errUnauthorized
 	}
 
@@ -64,14 +78,15 @@ func (s *Service) ListConsultationTopics(ctx context.Context, _ *emptypb.Empty)
 
 // ListAvailableConsultationSlots implements the available consultation dates listing endpoint.
 func (s *Service) ListAvailableConsultationDates(ctx context.Context, req *desc.ListAvailableConsultationDatesRequest) (*desc.ListAvailableConsultationDatesResponse, error) {
-	if _, authorized := s.authorizeSession(ctx, storage.AccountTypeBusiness); !authorized {
+	if _, authorized := s.authorizeSession(ctx); !authorized {
 		return nil, errUnauthorized
 	}
 
-	dates, err := s.db.ListAvailableConsultationDates(ctx, req.AuthorityId, req.FromDate.AsTime(), req.ToDate.AsTime())
+	dates, err := s.db.ListAvailableConsultationDates(ctx, req.AuthorityId, req.TopicId, req.FromDate.AsTime(), req.ToDate.AsTime())
 	if err != nil {
 		s.logger.Error("failed to list available consultation slots",
 			"authority_id", req.AuthorityId,
+			"topic_id", req.TopicId,
 			"from_date", req.FromDate.AsTime(),
 			"to_date", req.ToDate.AsTime(),
 			"error", err,
@@ -99,14 +114,15 @@ func (s *Service) ListAvailableConsultationDates(ctx context.Context, req *desc.
 
 // ListAvailableConsultationSlots implements the available consultation slots listing endpoint.
 func (s *Service) ListAvailableConsultationSlots(ctx context.Context, req *desc.ListAvailableConsultationSlotsRequest) (*desc.ListAvailableConsultationSlotsResponse, error) {
-	if _, authorized := s.authorizeSession(ctx, storage.AccountTypeBusiness); !authorized {
+	if _, authorized := s.authorizeSession(ctx); !authorized {
 		return nil, errUnauthorized
 	}
 
-	slots, err := s.db.ListAvailableConsultationSlots(ctx, req.AuthorityId, req.Date.AsTime())
+	slots, err := s.db.ListAvailableConsultationSlots(ctx, req.AuthorityId, req.TopicId, req.Date.AsTime())
 	if err != nil {
 		s.logger.Error("failed to list available consultation slots",
 			"authority_id", req.AuthorityId,
+			"topic_id", req.TopicId,
 			"date", req.Date.AsTime(),
 			"error", err,
 		)
@@ -133,11 +149,20 @@ func (s *Service) ListAvailableConsultationSlots(ctx context.Context, req *desc.
 
 // CreateConsultationAppointment implements the consultation appointment creation endpoint.
 func (s *Service) CreateConsultationAppointment(ctx context.Context, req *desc.CreateConsultationAppointmentRequest) (*desc.CreateConsultationAppointmentResponse, error) {
-	session, authorized := s.authorizeSession(ctx, storage.AccountTypeBusiness)
+	session, authorized := s.authorizeSession(ctx)
 	if !authorized {
 		return nil, errUnauthorized
 	}
 
+	question := strings.TrimSpace(req.Question)
+	if err := validateAppointmentDetails(question, req.Attachments); err != nil {
+		return nil, err
+	}
+
+	if err := s.requireVerifiedEmail(ctx, session.AccountID); err != nil {
+		return nil, err
+	}
+
 	businessUser, err := s.db.GetBusinessUser(ctx, session.AccountID)
 	if err != nil {
 		s.logger.Error("failed to get business user during consultation appointment creation",
@@ -147,7 +172,26 @@ func (s *Service) CreateConsultationAppointment(ctx context.Context, req *desc.C
 		return nil, errInternal
 	}
 
-	inspector, err := s.db.CreateConsultationAppointment(ctx, req.TopicId, req.SlotId, businessUser.ID)
+	var holdTokenHash []byte
+	if req.HoldToken != "" {
+		holdTokenHash = crypto.HashToken(req.HoldToken)
+	}
+
+	attachments, err := s.storeAttachments(ctx, req.Attachments)
+	if err != nil {
+		s.logger.Error("failed to store consultation appointment attachments",
+			"business_user_id", businessUser.ID,
+			"error", err,
+		)
+		return nil, errInternal
+	}
+
+	inspector, err := s.db.CreateConsultationAppointment(ctx, req.TopicId, req.SlotId, businessUser.ID,
+		holdTokenHash, question, attachments, assignment.Assign)
+	if err != nil {
+		s.deleteAttachmentBlobs(ctx, attachments)
+	}
+
 	if errors.Is(err, storage.ErrConsultationSlotExhausted) {
 		return nil, errSlotAlreadyTaken
 	} else if err != nil {
@@ -169,12 +213,41 @@ func (s *Service) CreateConsultationAppointment(ctx context.Context, req *desc.C
 }
 
 // CancelConsultationAppointment implements the consultation appointment cancelation endpoint.
+// Business users can cancel the appointments they've created, and inspectors the ones assigned to them.
 func (s *Service) CancelConsultationAppointment(ctx context.Context, req *desc.CancelConsultationAppointmentRequest) (*emptypb.Empty, error) {
-	session, authorized := s.authorizeSession(ctx, storage.AccountTypeBusiness)
+	session, authorized := s.authorizeSession(ctx)
 	if !authorized {
 		return nil, errUnauthorized
 	}
 
+	reason := strings.TrimSpace(req.Reason)
+	if utf8.RuneCountInString(reason) > maxCancelReasonLength {
+		return nil, errCancelReasonTooLong
+	} else if !govalidator.IsUUID(req.Id) {
+		return nil, errConsultationNotFound
+	}
+
+	if session.AccountType == storage.AccountTypeAuthority {
+		if reason == "" {
+			return nil, errCancelReasonRequired
+		}
+
+		err := s.db.CancelInspectorConsultationAppointment(ctx, req.Id, session.AccountID, reason)
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, errConsultationNotFound
+		} else if err != nil {
+			s.logger.Error("failed to mark consultation appointment as canceled by inspector in storage",
+				"consultation_id", req.Id,
+				"account_id", session.AccountID,
+				"error", err,
+			)
+			return nil, errInternal
+		}
+
+		s.waitlist.Notify()
+		return &emptypb.Empty{}, nil
+	}
+
 	businessUser, err := s.db.GetBusinessUser(ctx, session.AccountID)
 	if err != nil {
 		s.logger.Error("failed to get business user during consultation appointment cancelation",
@@ -184,7 +257,7 @@ func (s *Service) CancelConsultationAppointment(ctx context.Context, req *desc.C
 		return nil, errInternal
 	}
 
-	if err := s.db.CancelConsultationAppointment(ctx, req.Id, businessUser.ID); errors.Is(err, storage.ErrNotFound) {
+	if err := s.db.CancelConsultationAppointment(ctx, req.Id, businessUser.ID, reason); errors.Is(err, storage.ErrNotFound) {
 		return nil, errConsultationNotFound
 	} else if err != nil {
 		s.logger.Error("failed to mark consultation appointment as canceled in storage",
@@ -195,10 +268,182 @@ func (s *Service) CancelConsultationAppointment(ctx context.Context, req *desc.C
 		return nil, errInternal
 	}
 
+	s.waitlist.Notify()
+	return &emptypb.Empty{}, nil
+}
+
+// CancelAuthorityConsultationAppointment implements the cancelation endpoint for the appointments of an authority,
+// which is only available to API keys with the write scope.
+func (s *Service) CancelAuthorityConsultationAppointment(ctx context.Context, req *desc.CancelConsultationAppointmentRequest,
+) (*emptypb.Empty, error) {
+	session, authorized := s.authorizeSession(ctx)
+	if !authorized || session.APIKeyID == 0 {
+		return nil, errUnauthorized
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, errCancelReasonRequired
+	} else if utf8.RuneCountInString(reason) > maxCancelReasonLength {
+		return nil, errCancelReasonTooLong
+	} else if !govalidator.IsUUID(req.Id) {
+		return nil, errConsultationNotFound
+	}
+
+	err := s.db.CancelAuthorityConsultationAppointment(ctx, req.Id, session.AuthorityID, reason)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, errConsultationNotFound
+	} else if err != nil {
+		s.logger.Error("failed to mark consultation appointment as canceled by authority in storage",
+			"consultation_id", req.Id,
+			"authority_id", session.AuthorityID,
+			"api_key_id", session.APIKeyID,
+			"error", err,
+		)
+		return nil, errInternal
+	}
+
+	s.waitlist.Notify()
+	return &emptypb.Empty{}, nil
+}
+
+// RescheduleConsultationAppointment implements the consultation appointment rescheduling endpoint.
+func (s *Service) RescheduleConsultationAppointment(ctx context.Context, req *desc.RescheduleConsultationAppointmentRequest,
+) (*desc.RescheduleConsultationAppointmentResponse, error) {
+	session, authorized := s.authorizeSession(ctx)
+	if !authorized {
+		return nil, errUnauthorized
+	}
+
+	if !govalidator.IsUUID(req.Id) {
+		return nil, errConsultationNotFound
+	}
+
+	businessUser, err := s.db.GetBusinessUser(ctx, session.AccountID)
+	if err != nil {
+		s.logger.Error("failed to get business user during consultation appointment rescheduling",
+			"account_id", session.AccountID,
+			"error", err,
+		)
+		return nil, errInternal
+	}
+
+	inspector, err := s.db.RescheduleConsultationAppointment(ctx, req.Id, req.NewSlotId, businessUser.ID, assignment.Assign)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, errConsultationNotFound
+	} else if errors.Is(err, storage.ErrConsultationSlotExhausted) {
+		return nil, errSlotAlreadyTaken
+	} else if err != nil {
+		s.logger.Error("failed to reschedule consultation appointment in storage",
+			"consultation_id", req.Id,
+			"new_slot_id", req.NewSlotId,
+			"business_user_id", businessUser.ID,
+			"error", err,
+		)
+		return nil, errInternal
+	}
+
+	// The previous slot has been freed
+	s.waitlist.Notify()
+
+	return &desc.RescheduleConsultationAppointmentResponse{
+		Inspector: &desc.AuthorityUser{
+			FirstName: inspector.FirstName,
+			LastName:  inspector.LastName,
+		},
+	}, nil
+}
+
+// JoinConsultationWaitlist implements the consultation waitlist joining endpoint.
+func (s *Service) JoinConsultationWaitlist(ctx context.Context, req *desc.JoinConsultationWaitlistRequest) (*emptypb.Empty, error) {
+	session, authorized := s.authorizeSession(ctx)
+	if !authorized {
+		return nil, errUnauthorized
+	}
+
+	if req.FromDate == nil || req.ToDate == nil {
+		return nil, errMissingFields
+	}
+
+	fromDate, toDate := req.FromDate.AsTime(), req.ToDate.AsTime()
+	if toDate.Before(fromDate) || toDate.Before(time.Now().UTC().Truncate(time.Hour*24)) {
+		return nil, errInvalidDateRange
+	}
+
+	if err := s.requireVerifiedEmail(ctx, session.AccountID); err != nil {
+		return nil, err
+	}
+
+	businessUser, err := s.db.GetBusinessUser(ctx, session.AccountID)
+	if err != nil {
+		s.logger.Error("failed to get business user during consultation waitlist joining",
+			"account_id", session.AccountID,
+			"error", err,
+		)
+		return nil, errInternal
+	}
+
+	_, err = s.db.CreateWaitlistEntry(ctx, storage.WaitlistEntry{
+		BusinessUserID: businessUser.ID,
+		AuthorityID:    req.AuthorityId,
+		TopicID:        req.TopicId,
+		FromDate:       fromDate,
+		ToDate:         toDate,
+	})
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, errTopicNotFound
+	} else if errors.Is(err, storage.ErrAlreadyExists) {
+		return nil, errAlreadyWaitlisted
+	} else if err != nil {
+		s.logger.Error("failed to create consultation waitlist entry in storage",
+			"authority_id", req.AuthorityId,
+			"topic_id", req.TopicId,
+			"from_date", fromDate,
+			"to_date", toDate,
+			"business_user_id", businessUser.ID,
+			"error", err,
+		)
+		return nil, errInternal
+	}
+
+	// Slots might have opened up since the user has checked
+	s.waitlist.Notify()
+
+	return &emptypb.Empty{}, nil
+}
+
+// LeaveConsultationWaitlist implements the consultation waitlist leaving endpoint.
+func (s *Service) LeaveConsultationWaitlist(ctx context.Context, req *desc.LeaveConsultationWaitlistRequest) (*emptypb.Empty, error) {
+	session, authorized := s.authorizeSession(ctx)
+	if !authorized {
+		return nil, errUnauthorized
+	}
+
+	businessUser, err := s.db.GetBusinessUser(ctx, session.AccountID)
+	if err != nil {
+		s.logger.Error("failed to get business user during consultation waitlist leaving",
+			"account_id", session.AccountID,
+			"error", err,
+		)
+		return nil, errInternal
+	}
+
+	if err := s.db.DeleteWaitlistEntry(ctx, businessUser.ID, req.TopicId); errors.Is(err, storage.ErrNotFound) {
+		return nil, errNotWaitlisted
+	} else if err != nil {
+		s.logger.Error("failed to delete consultation waitlist entry in storage",
+			"topic_id", req.TopicId,
+			"business_user_id", businessUser.ID,
+			"error", err,
+		)
+		return nil, errInternal
+	}
+
 	return &emptypb.Empty{}, nil
 }
 
 // ListConsultationAppointments implements the appointment listing endpoint for both business and authority users.
+// Authority supervisors receive the appointments of all of the authority's inspectors.
 func (s *Service) ListConsultationAppointments(ctx context.Context, _ *emptypb.Empty) (*desc.ListConsultationAppointmentsResponse, error) {
 	session, authorized := s.authorizeSession(ctx)
 	if !authorized {
@@ -207,8 +452,12 @@ func (s *Service) ListConsultationAppointments(ctx context.Context, _ *emptypb.E
 
 	var err error
 	var appointments []storage.ConsultationAppointment
-	if session.AccountType == storage.AccountTypeBusiness {
+	if session.APIKeyID != 0 {
+		appointments, err = s.db.ListConsultationAppointmentsByAuthority(ctx, session.AuthorityID)
+	} else if session.AccountType == storage.AccountTypeBusiness {
 		appointments, err = s.db.ListBusinessConsultationAppointments(ctx, session.AccountID)
+	} else if session.HasPermission(PermissionViewAuthorityConsultations) {
+		appointments, err = s.db.ListAuthorityConsultationAppointments(ctx, session.AccountID)
 	} else if session.AccountType == storage.AccountTypeAuthority {
 		appointments, err = s.db.ListInspectorConsultationAppointments(ctx, session.AccountID)
 	}
@@ -217,6 +466,7 @@ func (s *Service) ListConsultationAppointments(ctx context.Context, _ *emptypb.E
 		s.logger.Error("failed to list user appointments",
 			"account_type", session.AccountType,
 			"account_id", session.AccountID,
+			"api_key_id", session.APIKeyID,
 			"error", err,
 		)
 		return nil, errInternal
@@ -244,7 +494,19 @@ func (s *Service) ListConsultationAppointments(ctx context.Context, _ *emptypb.E
 					LastName:      appointment.InspectorUser.LastName,
 					AuthorityName: appointment.InspectorUser.Authority.Name,
 				},
-				Canceled: appointment.CanceledAt != nil,
+				Canceled:     appointment.CanceledAt != nil,
+				CanceledBy:   cancelerFromStorage[appointment.CanceledBy],
+				CancelReason: appointment.CancelReason,
+				Question:     appointment.Question,
+				Attachments: lo.Map(appointment.Attachments, func(attachment storage.ConsultationAttachment, _ int,
+				) *desc.ListConsultationAppointmentsResponse_Attachment {
+					return &desc.ListConsultationAppointmentsResponse_Attachment{
+						Id:          attachment.ID,
+						Name:        attachment.Name,
+						ContentType: attachment.ContentType,
+						Size:        attachment.Size,
+					}
+				}),
 			}
 		}),
 	}, nil
@@ -0,0 +1,168 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"ldt-hack/api/internal/crypto"
+	"ldt-hack/api/internal/mail"
+	desc "ldt-hack/api/internal/pb/app/v1"
+	"ldt-hack/api/internal/storage"
+
+	"github.com/asaskevich/govalidator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	verificationCodeDigits     = 6
+	verificationCodeExpiry     = time.Hour * 24
+	verificationMaxAttempts    = 5
+	verificationResendInterval = time.Minute
+)
+
+var (
+	errVerificationInvalidCode  = status.Error(codes.InvalidArgument, "Указан неверный код подтверждения")
+	errVerificationNotRequested = status.Error(codes.FailedPrecondition, "Код подтверждения устарел, запросите новый")
+	errVerificationNotRequired  = status.Error(codes.FailedPrecondition, "Почтовый адрес уже подтвержден")
+	errVerificationTooFrequent  = status.Error(codes.ResourceExhausted, "Код подтверждения уже отправлен, попробуйте запросить новый через минуту")
+	errVerificationRequired     = status.Error(codes.FailedPrecondition, "Необходимо подтвердить почтовый адрес, перейдите в профиль для отправки кода")
+)
+
+// VerifyEmail implements the email verification endpoint.
+func (s *Service) VerifyEmail(ctx context.Context, req *desc.VerifyEmailRequest) (*emptypb.Empty, error) {
+	session, authorized := s.authorizeSession(ctx)
+	if !authorized {
+		return nil, errUnauthorized
+	}
+
+	err := s.db.VerifyEmail(ctx, session.AccountID, crypto.HashToken(req.Code), verificationMaxAttempts)
+	if errors.Is(err, storage.ErrVerificationCodeMismatch) {
+		return nil, errVerificationInvalidCode
+	} else if errors.Is(err, storage.ErrNotFound) {
+		return nil, errVerificationNotRequested
+	} else if errors.Is(err, storage.ErrAlreadyExists) {
+		return nil, errCreateEmailTaken
+	} else if err != nil {
+		s.logger.Error("failed to verify email in db", "account_id", session.AccountID, "error", err)
+		return nil, errInternal
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ResendVerification implements the email verification code resending endpoint.
+func (s *Service) ResendVerification(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	session, authorized := s.authorizeSession(ctx)
+	if !authorized {
+		return nil, errUnauthorized
+	}
+
+	// Resend the code to the email of the pending verification or to the unverified account email
+	var email string
+	if verification, err := s.db.GetEmailVerification(ctx, session.AccountID); err == nil {
+		if time.Since(verification.CreatedAt) < verificationResendInterval {
+			return nil, errVerificationTooFrequent
+		}
+
+		email = verification.Email
+	} else if errors.Is(err, storage.ErrNotFound) {
+		account, err := s.db.GetAccountByID(ctx, session.AccountID)
+		if err != nil {
+			s.logger.Error("failed to get account for verification", "account_id", session.AccountID, "error", err)
+			return nil, errInternal
+		} else if account.EmailVerifiedAt != nil {
+			return nil, errVerificationNotRequired
+		}
+
+		email = account.Email
+	} else {
+		s.logger.Error("failed to get email verification from db", "account_id", session.AccountID, "error", err)
+		return nil, errInternal
+	}
+
+	if err := s.sendEmailVerification(ctx, session.AccountID, email); err != nil {
+		s.logger.Error("failed to send email verification", "account_id", session.AccountID, "error", err)
+		return nil, errInternal
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ChangeEmail implements the email change endpoint.
+func (s *Service) ChangeEmail(ctx context.Context, req *desc.ChangeEmailRequest) (*emptypb.Empty, error) {
+	session, authorized := s.authorizeSession(ctx)
+	if !authorized {
+		return nil, errUnauthorized
+	}
+
+	if !govalidator.IsEmail(req.NewEmail) {
+		return nil, errCreateInvalidEmail
+	}
+
+	account, err := s.checkCurrentPassword(ctx, session.AccountID, req.Password)
+	if err != nil {
+		return nil, err
+	} else if account.Email == req.NewEmail {
+		return nil, errVerificationNotRequired
+	}
+
+	// Uniqueness is checked once again when the email is actually changed
+	if exists, err := s.db.CheckEmailExists(ctx, req.NewEmail); err != nil {
+		s.logger.Error("failed to check email existence in db", "email", req.NewEmail, "error", err)
+		return nil, errInternal
+	} else if exists {
+		return nil, errCreateEmailTaken
+	}
+
+	if err := s.sendEmailVerification(ctx, session.AccountID, req.NewEmail); err != nil {
+		s.logger.Error("failed to send email verification for email change", "account_id", session.AccountID, "error", err)
+		return nil, errInternal
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// sendEmailVerification creates a new verification of the email for the account and sends the code to it.
+func (s *Service) sendEmailVerification(ctx context.Context, accountID int64, email string) error {
+	code, err := crypto.GenerateCode(verificationCodeDigits)
+	if err != nil {
+		return err
+	}
+
+	if err := s.db.CreateEmailVerification(ctx, storage.EmailVerification{
+		AccountID: accountID,
+		Email:     email,
+		CodeHash:  crypto.HashToken(code),
+		ExpiresAt: time.Now().Add(verificationCodeExpiry),
+	}); err != nil {
+		return fmt.Errorf("creating email verification: %w", err)
+	}
+
+	if err := s.mailer.Send(ctx, mail.Message{
+		To:      email,
+		Subject: "Подтверждение почтового адреса",
+		Body: fmt.Sprintf("Код подтверждения почтового адреса: %s\n\n"+
+			"Введите его в приложении в течение суток. Если вы не регистрировались, просто проигнорируйте это письмо.", code),
+	}); err != nil {
+		return fmt.Errorf("sending verification mail: %w", err)
+	}
+
+	return nil
+}
+
+// requireVerifiedEmail returns an error if the account's email hasn't been verified yet.
+func (s *Service) requireVerifiedEmail(ctx context.Context, accountID int64) error {
+	account, err := s.db.GetAccountByID(ctx, accountID)
+	if err != nil {
+		s.logger.Error("failed to get account for email verification check", "account_id", accountID, "error", err)
+		return errInternal
+	} else if account.EmailVerifiedAt == nil {
+		return errVerificationRequired
+	}
+
+	return nil
+}
@@ -18,3 +18,8 @@ var sessionUserToStorage = map[desc.CreateSessionRequest_SessionUser]storage.Acc
 	desc.CreateSessionRequest_SESSION_USER_BUSINESS:  storage.AccountTypeBusiness,
 	desc.CreateSessionRequest_SESSION_USER_AUTHORITY: storage.AccountTypeAuthority,
 }
+
+var cancelerFromStorage = map[storage.AccountType]desc.ListConsultationAppointmentsResponse_Canceler{
+	storage.AccountTypeBusiness:  desc.ListConsultationAppointmentsResponse_CANCELER_BUSINESS,
+	storage.AccountTypeAuthority: desc.ListConsultationAppointmentsResponse_CANCELER_AUTHORITY,
+}
@@ -0,0 +1,211 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ldt-hack/api/internal/crypto"
+	"ldt-hack/api/internal/oidc"
+	desc "ldt-hack/api/internal/pb/app/v1"
+	"ldt-hack/api/internal/storage"
+
+	"github.com/asaskevich/govalidator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Time in which the user must complete the login at the external provider
+const externalLoginExpiry = time.Minute * 10
+
+var (
+	errExternalLoginDisabled      = status.Error(codes.Unimplemented, "Вход через внешний сервис недоступен")
+	errExternalLoginExpired       = status.Error(codes.FailedPrecondition, "Время входа через внешний сервис истекло, попробуйте еще раз")
+	errExternalLoginFailed        = status.Error(codes.Unauthenticated, "Не удалось войти через внешний сервис, попробуйте еще раз")
+	errExternalIdentityTaken      = status.Error(codes.AlreadyExists, "Этот внешний аккаунт уже привязан к другому пользователю")
+	errExternalEmailTaken         = status.Error(codes.AlreadyExists, "Пользователь с этой почтой уже существует, войдите с паролем и привяжите внешний аккаунт в профиле")
+	errExternalRegistrationClaims = status.Error(codes.FailedPrecondition, "Внешний сервис не предоставил данные, необходимые для регистрации")
+)
+
+// StartExternalLogin implements the external login start endpoint.
+func (s *Service) StartExternalLogin(ctx context.Context, _ *emptypb.Empty) (*desc.StartExternalLoginResponse, error) {
+	return s.startExternalLogin(ctx, nil)
+}
+
+// LinkExternalIdentity implements the external identity linking endpoint.
+func (s *Service) LinkExternalIdentity(ctx context.Context, _ *emptypb.Empty) (*desc.StartExternalLoginResponse, error) {
+	session, authorized := s.authorizeSession(ctx)
+	if !authorized {
+		return nil, errUnauthorized
+	}
+
+	return s.startExternalLogin(ctx, &session.AccountID)
+}
+
+// CompleteExternalLogin implements the external login completion endpoint.
+func (s *Service) CompleteExternalLogin(ctx context.Context, req *desc.CompleteExternalLoginRequest) (*desc.SessionToken, error) {
+	if s.identityProvider == nil {
+		return nil, errExternalLoginDisabled
+	} else if req.State == "" || req.Code == "" {
+		return nil, errMissingFields
+	}
+
+	login, err := s.db.ConsumeExternalLogin(ctx, crypto.HashToken(req.State))
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, errExternalLoginExpired
+	} else if err != nil {
+		s.logger.Error("failed to consume external login in db", "error", err)
+		return nil, errInternal
+	}
+
+	claims, err := s.identityProvider.Exchange(ctx, req.Code, login.CodeVerifier, login.Nonce)
+	if errors.Is(err, oidc.ErrExchangeFailed) || errors.Is(err, oidc.ErrInvalidIDToken) {
+		s.logger.Warn("failed to complete external login", "error", err)
+		return nil, errExternalLoginFailed
+	} else if err != nil {
+		s.logger.Error("failed to exchange external authorization code", "error", err)
+		return nil, errInternal
+	}
+
+	var account storage.Account
+	if login.LinkAccountID != nil {
+		account, err = s.linkExternalIdentity(ctx, *login.LinkAccountID, claims.Subject)
+	} else {
+		account, err = s.db.GetExternalIdentityAccount(ctx, s.identityProvider.Name(), claims.Subject)
+		if errors.Is(err, storage.ErrNotFound) {
+			account, err = s.registerExternalBusinessUser(ctx, claims)
+		} else if err != nil {
+			s.logger.Error("failed to get external identity account from db", "subject", claims.Subject, "error", err)
+			err = errInternal
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Authority users must still complete the second step if required
+	step, err := s.twoFactorStep(ctx, account)
+	if err != nil {
+		return nil, err
+	} else if step != desc.TwoFactorStep_TWO_FACTOR_STEP_NONE {
+		session := s.signPartialSession(account, step)
+		if session == nil {
+			return nil, errInternal
+		}
+
+		return session, nil
+	}
+
+	session := s.constructSession(ctx, "external login", account)
+	if session == nil {
+		return nil, errInternal
+	}
+
+	return session, nil
+}
+
+// startExternalLogin stores the state of a new external login, optionally linking the identity to the account.
+func (s *Service) startExternalLogin(ctx context.Context, linkAccountID *int64) (*desc.StartExternalLoginResponse, error) {
+	if s.identityProvider == nil {
+		return nil, errExternalLoginDisabled
+	}
+
+	var values [3]string
+	for i := range values {
+		value, err := crypto.GenerateToken()
+		if err != nil {
+			s.logger.Error("failed to generate external login token", "error", err)
+			return nil, errInternal
+		}
+
+		values[i] = value
+	}
+
+	state, nonce, codeVerifier := values[0], values[1], values[2]
+
+	authURL, err := s.identityProvider.AuthCodeURL(ctx, state, nonce, codeVerifier)
+	if err != nil {
+		s.logger.Error("failed to construct external authorization url", "error", err)
+		return nil, errInternal
+	}
+
+	if err := s.db.CreateExternalLogin(ctx, storage.ExternalLogin{
+		StateHash:     crypto.HashToken(state),
+		Nonce:         nonce,
+		CodeVerifier:  codeVerifier,
+		LinkAccountID: linkAccountID,
+		ExpiresAt:     time.Now().Add(externalLoginExpiry),
+	}); err != nil {
+		s.logger.Error("failed to create external login in db", "error", err)
+		return nil, errInternal
+	}
+
+	return &desc.StartExternalLoginResponse{AuthorizationUrl: authURL, State: state}, nil
+}
+
+// linkExternalIdentity links the subject to the account, which is returned for logging in.
+func (s *Service) linkExternalIdentity(ctx context.Context, accountID int64, subject string) (storage.Account, error) {
+	provider := s.identityProvider.Name()
+
+	err := s.db.LinkExternalIdentity(ctx, provider, subject, accountID)
+	if errors.Is(err, storage.ErrAlreadyExists) {
+		// Linking the same identity twice is fine
+		linked, err := s.db.GetExternalIdentityAccount(ctx, provider, subject)
+		if err != nil || linked.ID != accountID {
+			return storage.Account{}, errExternalIdentityTaken
+		}
+	} else if err != nil {
+		s.logger.Error("failed to link external identity in db", "account_id", accountID, "error", err)
+		return storage.Account{}, errInternal
+	}
+
+	account, err := s.db.GetAccountByID(ctx, accountID)
+	if err != nil {
+		s.logger.Error("failed to get linked account from db", "account_id", accountID, "error", err)
+		return storage.Account{}, errInternal
+	}
+
+	return account, nil
+}
+
+// registerExternalBusinessUser registers a new business user using the claims returned by the provider.
+// The business name isn't known to the provider, so it must be filled in by the user later.
+func (s *Service) registerExternalBusinessUser(ctx context.Context, claims oidc.Claims) (storage.Account, error) {
+	birthDate, err := time.Parse(time.DateOnly, claims.Birthdate)
+	if err != nil || !govalidator.IsEmail(claims.Email) ||
+		claims.GivenName == "" || claims.FamilyName == "" || claims.PhoneNumber == "" {
+		return storage.Account{}, errExternalRegistrationClaims
+	}
+
+	sex := storage.PersonSex(claims.Gender)
+	if sex != storage.PersonSexMale && sex != storage.PersonSexFemale {
+		return storage.Account{}, errExternalRegistrationClaims
+	}
+
+	account, err := s.db.CreateExternalBusinessUser(ctx, s.identityProvider.Name(), claims.Subject,
+		claims.Email, claims.EmailVerified, storage.BusinessUser{
+			FirstName:      claims.GivenName,
+			PatronymicName: claims.MiddleName,
+			LastName:       claims.FamilyName,
+			Sex:            sex,
+			BirthDate:      birthDate,
+			PhoneNumber:    claims.PhoneNumber,
+		})
+	if errors.Is(err, storage.ErrAlreadyExists) {
+		return storage.Account{}, errExternalEmailTaken
+	} else if err != nil {
+		s.logger.Error("failed to create external business user in db", "subject", claims.Subject, "error", err)
+		return storage.Account{}, errInternal
+	}
+
+	if !claims.EmailVerified {
+		if err := s.sendEmailVerification(ctx, account.ID, account.Email); err != nil {
+			// Not critical since the code can be resent later
+			s.logger.Error("failed to send email verification after creation", "account_id", account.ID, "error", err)
+		}
+	}
+
+	return account, nil
+}
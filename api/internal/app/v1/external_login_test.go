@@ -0,0 +1,50 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"ldt-hack/api/internal/oidc"
+	"ldt-hack/api/internal/oidc/oidctest"
+	desc "ldt-hack/api/internal/pb/app/v1"
+)
+
+// The requests rejected before the stored login is looked up, which is the only part of the flow testable without a database.
+func TestCompleteExternalLoginRejectsIncompleteRequests(t *testing.T) {
+	server, err := oidctest.NewServer("client", "secret", oidc.Claims{Subject: "user-1"})
+	if err != nil {
+		t.Fatalf("starting provider stub: %v", err)
+	}
+	t.Cleanup(server.Close)
+
+	provider := oidc.NewProvider(server.Config("https://app.example.com/login/callback"), server.Client())
+	authURL, err := provider.AuthCodeURL(context.Background(), "state", "nonce", "code-verifier-which-is-long-enough")
+	if err != nil {
+		t.Fatalf("building authorization url: %v", err)
+	}
+
+	code, state, err := server.Authorize(authURL)
+	if err != nil {
+		t.Fatalf("authorizing: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		provider *oidc.Provider
+		req      *desc.CompleteExternalLoginRequest
+		want     error
+	}{
+		{"disabled", nil, &desc.CompleteExternalLoginRequest{Code: code, State: state}, errExternalLoginDisabled},
+		{"missing state", provider, &desc.CompleteExternalLoginRequest{Code: code}, errMissingFields},
+		{"missing code", provider, &desc.CompleteExternalLoginRequest{State: state}, errMissingFields},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{identityProvider: tt.provider}
+			if _, err := s.CompleteExternalLogin(context.Background(), tt.req); err != tt.want {
+				t.Errorf("error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
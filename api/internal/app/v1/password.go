@@ -0,0 +1,158 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"ldt-hack/api/internal/crypto"
+	"ldt-hack/api/internal/mail"
+	desc "ldt-hack/api/internal/pb/app/v1"
+	"ldt-hack/api/internal/storage"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const passwordResetTokenExpiry = time.Hour
+
+var (
+	errPasswordResetInvalidToken = status.Error(codes.InvalidArgument, "Код для сброса пароля недействителен или устарел, запросите новый")
+	errPasswordInvalidCurrent    = status.Error(codes.InvalidArgument, "Указан неправильный текущий пароль")
+)
+
+// RequestPasswordReset implements the password reset request endpoint.
+func (s *Service) RequestPasswordReset(ctx context.Context, req *desc.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	accountType, ok := sessionUserToStorage[req.SessionUser]
+	if !ok {
+		return nil, errSessionUnknownType
+	}
+
+	// Throttled before looking up the account so that the requests for missing accounts are indistinguishable
+	throttleKeys := passwordResetThrottleKeys(ctx, req.Email)
+	if err := s.checkPasswordResetThrottle(ctx, throttleKeys); err != nil {
+		return nil, err
+	}
+	s.recordPasswordResetRequest(ctx, throttleKeys)
+
+	// Don't disclose whether the account exists or not
+	account, err := s.db.GetAccount(ctx, req.Email, accountType)
+	if errors.Is(err, storage.ErrNotFound) {
+		return &emptypb.Empty{}, nil
+	} else if err != nil {
+		s.logger.Error("failed to get account for password reset", "email", req.Email, "error", err)
+		return nil, errInternal
+	}
+
+	token, err := crypto.GenerateToken()
+	if err != nil {
+		s.logger.Error("failed to generate password reset token", "error", err)
+		return nil, errInternal
+	}
+
+	err = s.db.CreatePasswordResetToken(ctx, account.ID, crypto.HashToken(token), time.Now().Add(passwordResetTokenExpiry))
+	if err != nil {
+		s.logger.Error("failed to create password reset token in db", "account_id", account.ID, "error", err)
+		return nil, errInternal
+	}
+
+	if err := s.mailer.Send(ctx, mail.Message{
+		To:      account.Email,
+		Subject: "Сброс пароля",
+		Body: fmt.Sprintf("Для сброса пароля используйте код: %s\n\n"+
+			"Код действителен в течение часа. Если вы не запрашивали сброс пароля, просто проигнорируйте это письмо.", token),
+	}); err != nil {
+		s.logger.Error("failed to send password reset mail", "account_id", account.ID, "error", err)
+		return nil, errInternal
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ConfirmPasswordReset implements the password reset confirmation endpoint.
+func (s *Service) ConfirmPasswordReset(ctx context.Context, req *desc.ConfirmPasswordResetRequest) (*emptypb.Empty, error) {
+	if req.Token == "" {
+		return nil, errPasswordResetInvalidToken
+	} else if err := s.validatePassword(req.Password); err != nil {
+		return nil, err
+	}
+
+	passwordHash, err := crypto.HashPassword(req.Password)
+	if err != nil {
+		s.logger.Error("failed to hash password", "error", err)
+		return nil, errInternal
+	}
+
+	accountID, err := s.db.ResetPassword(ctx, crypto.HashToken(req.Token), passwordHash)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, errPasswordResetInvalidToken
+	} else if err != nil {
+		s.logger.Error("failed to reset password in db", "error", err)
+		return nil, errInternal
+	}
+
+	s.logger.Info("password reset, all sessions revoked", "account_id", accountID)
+	return &emptypb.Empty{}, nil
+}
+
+// ChangePassword implements the password change endpoint.
+func (s *Service) ChangePassword(ctx context.Context, req *desc.ChangePasswordRequest) (*emptypb.Empty, error) {
+	session, authorized := s.authorizeSession(ctx)
+	if !authorized {
+		return nil, errUnauthorized
+	}
+
+	if err := s.validatePassword(req.NewPassword); err != nil {
+		return nil, err
+	} else if _, err := s.checkCurrentPassword(ctx, session.AccountID, req.CurrentPassword); err != nil {
+		return nil, err
+	}
+
+	passwordHash, err := crypto.HashPassword(req.NewPassword)
+	if err != nil {
+		s.logger.Error("failed to hash password", "error", err)
+		return nil, errInternal
+	}
+
+	if err := s.db.UpdatePassword(ctx, session.AccountID, passwordHash, session.TokenID); err != nil {
+		s.logger.Error("failed to update password in db", "account_id", session.AccountID, "error", err)
+		return nil, errInternal
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// checkCurrentPassword returns the account if the password matches its current one.
+func (s *Service) checkCurrentPassword(ctx context.Context, accountID int64, password string) (storage.Account, error) {
+	account, err := s.db.GetAccountByID(ctx, accountID)
+	if err != nil {
+		s.logger.Error("failed to get account for password check", "account_id", accountID, "error", err)
+		return storage.Account{}, errInternal
+	}
+
+	if !crypto.ValidateHashedPassword(password, account.PasswordHash) {
+		return storage.Account{}, errPasswordInvalidCurrent
+	}
+
+	return account, nil
+}
+
+// rehashPassword upgrades the account's password hash if it has been created using an outdated algorithm or parameters.
+// Failures are only logged since the old hash is still perfectly usable.
+func (s *Service) rehashPassword(ctx context.Context, account storage.Account, password string) {
+	if !crypto.PasswordNeedsRehash(account.PasswordHash) {
+		return
+	}
+
+	passwordHash, err := crypto.HashPassword(password)
+	if err != nil {
+		s.logger.Error("failed to hash password", "operation", "rehash", "error", err)
+		return
+	}
+
+	if err := s.db.RehashPassword(ctx, account.ID, account.PasswordHash, passwordHash); err != nil {
+		s.logger.Error("failed to update rehashed password in db", "account_id", account.ID, "error", err)
+	}
+}
@@ -0,0 +1,137 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+
+	"ldt-hack/api/internal/auth"
+	desc "ldt-hack/api/internal/pb/app/v1"
+	"ldt-hack/api/internal/storage"
+
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// Managing the user's own account: sessions, credentials, etc
+	PermissionManageAccount auth.Permission = "account:manage"
+	// Updating and deleting the business profile
+	PermissionManageBusiness auth.Permission = "business:manage"
+	// Talking to the chat bot
+	PermissionUseChatBot auth.Permission = "chatbot:use"
+	// Booking and canceling consultations
+	PermissionBookConsultations auth.Permission = "consultations:book"
+	// Canceling consultations with the user's participation
+	PermissionCancelConsultations auth.Permission = "consultations:cancel"
+	// Viewing consultations with the user's participation
+	PermissionViewConsultations auth.Permission = "consultations:view"
+	// Viewing all of the consultations of the user's authority
+	PermissionViewAuthorityConsultations auth.Permission = "consultations:view_authority"
+	// Enrolling into and managing two-factor authentication
+	PermissionManageTwoFactor auth.Permission = "two_factor:manage"
+	// Completing the login using the second factor, only granted to partial tokens
+	PermissionVerifyTwoFactor auth.Permission = "two_factor:verify"
+	// Managing all of the consultations of the authority, only granted to API keys with the write scope
+	PermissionManageAuthorityConsultations auth.Permission = "consultations:manage_authority"
+)
+
+var rolePermissions = map[storage.AccountRole][]auth.Permission{
+	storage.AccountRoleBusinessOwner: {
+		PermissionManageAccount,
+		PermissionManageBusiness,
+		PermissionUseChatBot,
+		PermissionBookConsultations,
+		PermissionCancelConsultations,
+		PermissionViewConsultations,
+	},
+	storage.AccountRoleBusinessEmployee: {
+		PermissionManageAccount,
+		PermissionUseChatBot,
+		PermissionBookConsultations,
+		PermissionCancelConsultations,
+		PermissionViewConsultations,
+	},
+	storage.AccountRoleInspector: {
+		PermissionManageAccount,
+		PermissionCancelConsultations,
+		PermissionViewConsultations,
+		PermissionManageTwoFactor,
+	},
+	storage.AccountRoleAuthoritySupervisor: {
+		PermissionManageAccount,
+		PermissionCancelConsultations,
+		PermissionViewConsultations,
+		PermissionViewAuthorityConsultations,
+		PermissionManageTwoFactor,
+	},
+}
+
+var apiKeyScopePermissions = map[storage.APIKeyScope][]auth.Permission{
+	storage.APIKeyScopeRead: {
+		PermissionViewConsultations,
+		PermissionViewAuthorityConsultations,
+	},
+	storage.APIKeyScopeWrite: {
+		PermissionViewConsultations,
+		PermissionViewAuthorityConsultations,
+		PermissionManageAuthorityConsultations,
+	},
+}
+
+// Policies returns the access policies of the service's methods to be used by the authorization interceptor.
+// The policies are read from the (auth) options of the methods, and an error is returned if any of them
+// are missing or reference unknown account types or permissions.
+func Policies() (map[string]auth.Policy, error) {
+	knownPermissions := append(lo.Flatten(lo.Values(rolePermissions)), lo.Flatten(lo.Values(apiKeyScopePermissions))...)
+	knownPermissions = append(lo.Uniq(knownPermissions), PermissionVerifyTwoFactor)
+
+	return auth.LoadPolicies(desc.AppService_ServiceDesc.ServiceName, func(method protoreflect.MethodDescriptor,
+	) (auth.Policy, bool, error) {
+		if !proto.HasExtension(method.Options(), desc.E_Auth) {
+			return auth.Policy{}, false, nil
+		}
+
+		options := proto.GetExtension(method.Options(), desc.E_Auth).(*desc.AuthOptions)
+		if options.Public && (len(options.AccountTypes) > 0 || len(options.Permissions) > 0) {
+			return auth.Policy{}, false, errors.New("public methods can't require account types or permissions")
+		}
+
+		for _, accountType := range options.AccountTypes {
+			if _, ok := storage.AccountTypeRoles[storage.AccountType(accountType)]; !ok {
+				return auth.Policy{}, false, fmt.Errorf("unknown account type %q", accountType)
+			}
+		}
+
+		permissions := lo.Map(options.Permissions, func(p string, _ int) auth.Permission {
+			return auth.Permission(p)
+		})
+		for _, permission := range permissions {
+			if !lo.Contains(knownPermissions, permission) {
+				return auth.Policy{}, false, fmt.Errorf("unknown permission %q", permission)
+			}
+		}
+
+		return auth.Policy{
+			Public:       options.Public,
+			AccountTypes: options.AccountTypes,
+			Permissions:  permissions,
+		}, true, nil
+	})
+}
+
+// HasAccountType checks if the session belongs to an account of the specified type.
+func (s Session) HasAccountType(accountType string) bool {
+	return string(s.AccountType) == accountType
+}
+
+// HasPermission checks if the session has been granted the permission.
+func (s Session) HasPermission(permission auth.Permission) bool {
+	for _, p := range s.Permissions {
+		if p == permission {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,48 @@
+package app
+
+import (
+	"testing"
+
+	"ldt-hack/api/internal/storage"
+)
+
+func TestAPIKeyScopePolicies(t *testing.T) {
+	policies, err := Policies()
+	if err != nil {
+		t.Fatalf("loading policies: %v", err)
+	}
+
+	tests := []struct {
+		method string
+		scope  storage.APIKeyScope
+		want   bool
+	}{
+		{"ListConsultationAppointments", storage.APIKeyScopeRead, true},
+		{"ListConsultationAppointments", storage.APIKeyScopeWrite, true},
+		{"CancelAuthorityConsultationAppointment", storage.APIKeyScopeRead, false},
+		{"CancelAuthorityConsultationAppointment", storage.APIKeyScopeWrite, true},
+		{"CancelConsultationAppointment", storage.APIKeyScopeWrite, false},
+	}
+
+	for _, tt := range tests {
+		policy, ok := policies["/ldt_hack.app.v1.AppService/"+tt.method]
+		if !ok {
+			t.Fatalf("no policy for %s", tt.method)
+		}
+
+		session := Session{
+			AccountType: storage.AccountTypeAuthority,
+			Permissions: apiKeyScopePermissions[tt.scope],
+			APIKeyID:    1,
+		}
+
+		allowed := true
+		for _, permission := range policy.Permissions {
+			allowed = allowed && session.HasPermission(permission)
+		}
+
+		if allowed != tt.want {
+			t.Errorf("%s with %s scope: allowed = %v, want %v", tt.method, tt.scope, allowed, tt.want)
+		}
+	}
+}
@@ -1,10 +1,17 @@
 package app
 
 import (
+	"time"
+
 	"ldt-hack/api/internal/auth"
+	"ldt-hack/api/internal/blob"
 	"ldt-hack/api/internal/bot"
+	"ldt-hack/api/internal/mail"
+	"ldt-hack/api/internal/oidc"
+	"ldt-hack/api/internal/passwords"
 	desc "ldt-hack/api/internal/pb/app/v1"
 	"ldt-hack/api/internal/storage"
+	"ldt-hack/api/internal/waitlist"
 
 	"golang.org/x/exp/slog"
 	"google.golang.org/grpc"
@@ -26,10 +33,36 @@ type Service struct {
 	db         *storage.Database
 	bc         *bot.Client
 	authorizer *auth.Authorizer
+	mailer     mail.Sender
+	passwords  *passwords.Policy
+	// identityProvider is nil if the external login is disabled
+	identityProvider *oidc.Provider
+	caches           appCaches
+	waitlist         *waitlist.Processor
+	// Time for which the slots are held for the business users during booking
+	slotHoldTTL time.Duration
+	// Contents of the files attached to the appointments
+	blobs blob.Store
 }
 
-func NewService(logger *slog.Logger, db *storage.Database, bc *bot.Client, authorizer *auth.Authorizer) *Service {
-	return &Service{logger: logger.With("component", "app"), db: db, bc: bc, authorizer: authorizer}
+func NewService(logger *slog.Logger, db *storage.Database, bc *bot.Client, authorizer *auth.Authorizer, mailer mail.Sender,
+	passwordPolicy *passwords.Policy, identityProvider *oidc.Provider, cacheOptions CacheOptions, waitlist *waitlist.Processor,
+	slotHoldTTL time.Duration, blobs blob.Store,
+) *Service {
+	return &Service{
+		logger:     logger.With("component", "app"),
+		db:         db,
+		bc:         bc,
+		authorizer: authorizer,
+		mailer:     mailer,
+		passwords:  passwordPolicy,
+
+		identityProvider: identityProvider,
+		caches:           newAppCaches(cacheOptions),
+		waitlist:         waitlist,
+		slotHoldTTL:      slotHoldTTL,
+		blobs:            blobs,
+	}
 }
 
 // RegisterServer registers this service with the gRPC server.
@@ -2,27 +2,57 @@ package app
 
 import (
 	"context"
+	"errors"
+	"net"
+	"strings"
+	"time"
 
 	"ldt-hack/api/internal/auth"
 	"ldt-hack/api/internal/crypto"
 	desc "ldt-hack/api/internal/pb/app/v1"
 	"ldt-hack/api/internal/storage"
 
+	"github.com/asaskevich/govalidator"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/samber/lo"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	sessionTokenExpiry = time.Minute * 15
+	refreshTokenExpiry = time.Hour * 24 * 30
+	// Minimum interval between updates of a session's last seen time
+	sessionTouchInterval = time.Minute
+)
+
 var (
 	errSessionUnknownType  = status.Error(codes.InvalidArgument, "Указан неподдерживаемый тип пользователя")
 	errSessionInvalidCreds = status.Error(codes.Unauthenticated, "Указан несуществующий почтовый адрес или неправильный пароль")
+	errSessionExpired      = status.Error(codes.Unauthenticated, "Сессия истекла, необходимо войти заново")
+	errSessionNotFound     = status.Error(codes.NotFound, "Выбрана несуществующая сессия")
 )
 
 // Session is the struct which is encoded in a user's session
 type Session struct {
+	// TokenID is the ID of the server-side session for which the token was issued
+	TokenID     string              `json:"jti"`
 	AccountID   int64               `json:"account_id"`
 	AccountType storage.AccountType `json:"account_type"`
+	Role        storage.AccountRole `json:"role"`
+	Permissions []auth.Permission   `json:"permissions"`
+	// Partial tokens are issued before the second authentication factor is verified,
+	// and don't have a server-side session
+	Partial bool             `json:"partial,omitempty"`
+	Expiry  *jwt.NumericDate `json:"exp"`
+	// APIKeyID and AuthorityID are only set for requests authorized using an API key,
+	// in which case there is no account behind the session
+	APIKeyID    int64 `json:"-"`
+	AuthorityID int64 `json:"-"`
 }
 
 // CreateSession implements the session creation endpoint.
@@ -32,16 +62,39 @@ func (s *Service) CreateSession(ctx context.Context, req *desc.CreateSessionRequ
 		return nil, errSessionUnknownType
 	}
 
+	// Check throttling before hashing anything to avoid wasting CPU on throttled requests
+	throttleKeys := loginThrottleKeys(ctx, req.Email)
+	if err := s.checkLoginThrottle(ctx, throttleKeys); err != nil {
+		return nil, err
+	}
+
 	account, err := s.db.GetAccount(ctx, req.Email, accountType)
 	if err != nil {
+		s.recordLoginFailure(ctx, throttleKeys)
 		return nil, errSessionInvalidCreds
 	}
 
 	if !crypto.ValidateHashedPassword(req.Password, account.PasswordHash) {
+		s.recordLoginFailure(ctx, throttleKeys)
 		return nil, errSessionInvalidCreds
 	}
 
-	session := s.constructSession("login", account.ID, accountType)
+	s.resetLoginFailures(ctx, req.Email)
+	s.rehashPassword(ctx, account, req.Password)
+
+	step, err := s.twoFactorStep(ctx, account)
+	if err != nil {
+		return nil, err
+	} else if step != desc.TwoFactorStep_TWO_FACTOR_STEP_NONE {
+		session := s.signPartialSession(account, step)
+		if session == nil {
+			return nil, errInternal
+		}
+
+		return session, nil
+	}
+
+	session := s.constructSession(ctx, "login", account)
 	if session == nil {
 		return nil, errInternal
 	}
@@ -49,6 +102,160 @@ func (s *Service) CreateSession(ctx context.Context, req *desc.CreateSessionRequ
 	return session, nil
 }
 
+// RefreshSession implements the session refresh endpoint.
+func (s *Service) RefreshSession(ctx context.Context, req *desc.RefreshSessionRequest) (*desc.SessionToken, error) {
+	if req.RefreshToken == "" {
+		return nil, errSessionExpired
+	}
+
+	refreshToken, err := crypto.GenerateToken()
+	if err != nil {
+		s.logger.Error("failed to generate refresh token", "operation", "refresh", "error", err)
+		return nil, errInternal
+	}
+
+	accountSession, err := s.db.RotateRefreshToken(ctx,
+		crypto.HashToken(req.RefreshToken),
+		crypto.HashToken(refreshToken),
+		time.Now().Add(refreshTokenExpiry),
+	)
+	if errors.Is(err, storage.ErrRefreshTokenReused) {
+		s.logger.Warn("reuse of refresh token detected, revoked its session",
+			"account_id", accountSession.AccountID,
+			"session_id", accountSession.ID,
+		)
+		return nil, errSessionExpired
+	} else if errors.Is(err, storage.ErrNotFound) {
+		return nil, errSessionExpired
+	} else if err != nil {
+		s.logger.Error("failed to rotate refresh token in db", "error", err)
+		return nil, errInternal
+	}
+
+	session := s.signSession("refresh", accountSession.ID, accountSession.Account, refreshToken)
+	if session == nil {
+		return nil, errInternal
+	}
+
+	return session, nil
+}
+
+// DeleteSession implements the logout endpoint.
+func (s *Service) DeleteSession(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	session, authorized := s.authorizeSession(ctx)
+	if !authorized {
+		return nil, errUnauthorized
+	}
+
+	if err := s.db.RevokeSession(ctx, session.TokenID, session.AccountID); err != nil && !errors.Is(err, storage.ErrNotFound) {
+		s.logger.Error("failed to revoke current session in db",
+			"account_id", session.AccountID,
+			"session_id", session.TokenID,
+			"error", err,
+		)
+		return nil, errInternal
+	}
+
+	s.caches.sessions.Delete(session.TokenID)
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListSessions implements the active session listing endpoint.
+func (s *Service) ListSessions(ctx context.Context, _ *emptypb.Empty) (*desc.ListSessionsResponse, error) {
+	session, authorized := s.authorizeSession(ctx)
+	if !authorized {
+		return nil, errUnauthorized
+	}
+
+	sessions, err := s.db.ListActiveSessions(ctx, session.AccountID)
+	if err != nil {
+		s.logger.Error("failed to list active sessions in db", "account_id", session.AccountID, "error", err)
+		return nil, errInternal
+	}
+
+	return &desc.ListSessionsResponse{
+		Sessions: lo.Map(sessions, func(accountSession storage.AccountSession, _ int) *desc.ListSessionsResponse_SessionInfo {
+			return &desc.ListSessionsResponse_SessionInfo{
+				Id:         accountSession.ID,
+				Device:     accountSession.Device,
+				IpAddress:  accountSession.IPAddress,
+				CreatedAt:  timestamppb.New(accountSession.CreatedAt),
+				LastSeenAt: timestamppb.New(accountSession.LastSeenAt),
+				Current:    accountSession.ID == session.TokenID,
+			}
+		}),
+	}, nil
+}
+
+// RevokeSession implements the session revocation endpoint.
+func (s *Service) RevokeSession(ctx context.Context, req *desc.RevokeSessionRequest) (*emptypb.Empty, error) {
+	session, authorized := s.authorizeSession(ctx)
+	if !authorized {
+		return nil, errUnauthorized
+	}
+
+	if !govalidator.IsUUID(req.Id) {
+		return nil, errSessionNotFound
+	}
+
+	if err := s.db.RevokeSession(ctx, req.Id, session.AccountID); errors.Is(err, storage.ErrNotFound) {
+		return nil, errSessionNotFound
+	} else if err != nil {
+		s.logger.Error("failed to revoke session in db",
+			"account_id", session.AccountID,
+			"session_id", req.Id,
+			"error", err,
+		)
+		return nil, errInternal
+	}
+
+	s.caches.sessions.Delete(req.Id)
+
+	return &emptypb.Empty{}, nil
+}
+
+// ValidateSession validates that the session's token hasn't been revoked.
+// It is meant to be used by the authorization interceptor for all incoming tokens.
+func (s *Service) ValidateSession(ctx context.Context, session Session) bool {
+	// Partial tokens are short-lived and can only be used to complete the login
+	if session.Partial {
+		return true
+	}
+
+	// Taken before the lookup, so that an invalidation received while querying the session isn't overwritten by it
+	version := s.caches.sessions.Version()
+
+	// Cached sessions might have been extended since, in which case they are simply queried again
+	accountSession, cached := s.caches.session(session.TokenID)
+	if !cached || accountSession.AccountID != session.AccountID || !accountSession.ExpiresAt.After(time.Now()) {
+		var err error
+		accountSession, err = s.db.GetActiveSession(ctx, session.TokenID, session.AccountID)
+		if errors.Is(err, storage.ErrNotFound) {
+			return false
+		} else if err != nil {
+			s.logger.Error("failed to get session from db",
+				"account_id", session.AccountID,
+				"session_id", session.TokenID,
+				"error", err,
+			)
+			return false
+		}
+	}
+
+	if time.Since(accountSession.LastSeenAt) > sessionTouchInterval {
+		if err := s.db.TouchSession(ctx, accountSession.ID); err != nil {
+			s.logger.Error("failed to update session last seen time in db", "session_id", accountSession.ID, "error", err)
+		} else {
+			accountSession.LastSeenAt = time.Now()
+		}
+	}
+
+	s.caches.sessions.SetIfVersion(accountSession.ID, accountSession, version)
+
+	return true
+}
+
 // GetSessionUser gets the authorized users' info.
 func (s *Service) GetSessionUser(ctx context.Context, _ *emptypb.Empty) (*desc.GetSessionUserResponse, error) {
 	session, authorized := s.authorizeSession(ctx)
@@ -89,7 +296,22 @@ func (s *Service) GetSessionUser(ctx context.Context, _ *emptypb.Empty) (*desc.G
 		return nil, errInternal
 	}
 
-	resp := &desc.GetSessionUserResponse{}
+	account, err := s.db.GetAccountByID(ctx, session.AccountID)
+	if err != nil {
+		s.logger.Error("failed to retrieve authorized user account from db", "account_id", session.AccountID, "error", err)
+		return nil, errInternal
+	}
+
+	twoFactorStatus, err := s.db.GetTwoFactorStatus(ctx, session.AccountID)
+	if err != nil {
+		s.logger.Error("failed to retrieve authorized user two-factor status from db", "account_id", session.AccountID, "error", err)
+		return nil, errInternal
+	}
+
+	resp := &desc.GetSessionUserResponse{
+		EmailVerified:    account.EmailVerifiedAt != nil,
+		TwoFactorEnabled: twoFactorStatus.Enabled,
+	}
 	if businessUser != nil {
 		resp.User = &desc.GetSessionUserResponse_Business{Business: businessUser}
 	} else if authorityUser != nil {
@@ -99,26 +321,77 @@ func (s *Service) GetSessionUser(ctx context.Context, _ *emptypb.Empty) (*desc.G
 	return resp, nil
 }
 
-func (s *Service) constructSession(operation string, accountID int64, accountType storage.AccountType) *desc.SessionToken {
-	token, err := s.authorizer.Construct(Session{AccountID: accountID, AccountType: accountType})
+// constructSession constructs a completely new server-side session for the client which initiated the request.
+func (s *Service) constructSession(ctx context.Context, operation string, account storage.Account) *desc.SessionToken {
+	refreshToken, err := crypto.GenerateToken()
+	if err != nil {
+		s.logger.Error("failed to generate refresh token", "operation", operation, "account_id", account.ID, "error", err)
+		return nil
+	}
+
+	device, ipAddress := clientInfoFromCtx(ctx)
+	sessionID, err := s.db.CreateSession(ctx, storage.AccountSession{
+		AccountID: account.ID,
+		Device:    device,
+		IPAddress: ipAddress,
+		ExpiresAt: time.Now().Add(refreshTokenExpiry),
+	}, crypto.HashToken(refreshToken))
 	if err != nil {
-		s.logger.Error("failed to construct user token", "operation", operation, "account_id", accountID, "error", err)
+		s.logger.Error("failed to create session in db", "operation", operation, "account_id", account.ID, "error", err)
 		return nil
 	}
 
-	return &desc.SessionToken{Token: token}
+	return s.signSession(operation, sessionID, account, refreshToken)
 }
 
-func (s *Service) authorizeSession(ctx context.Context, accountType ...storage.AccountType) (Session, bool) {
+// signSession constructs a new short-lived session token which is returned along with the refresh token.
+// The role and permissions are taken from the account, so changes to them are applied when the session is refreshed.
+func (s *Service) signSession(operation string, sessionID string, account storage.Account, refreshToken string,
+) *desc.SessionToken {
+	expiresAt := time.Now().Add(sessionTokenExpiry)
+
+	token, err := s.authorizer.Construct(Session{
+		TokenID:     sessionID,
+		AccountID:   account.ID,
+		AccountType: account.Type,
+		Role:        account.Role,
+		Permissions: rolePermissions[account.Role],
+		Expiry:      jwt.NewNumericDate(expiresAt),
+	})
+	if err != nil {
+		s.logger.Error("failed to construct user token", "operation", operation, "account_id", account.ID, "error", err)
+		return nil
+	}
+
+	return &desc.SessionToken{
+		Token:        token,
+		ExpiresAt:    timestamppb.New(expiresAt),
+		RefreshToken: refreshToken,
+	}
+}
+
+// authorizeSession returns the session authorized by the interceptor, checking that its account still exists.
+// Access to the endpoint itself is checked by the interceptor according to the method policies.
+func (s *Service) authorizeSession(ctx context.Context) (Session, bool) {
 	session := auth.ClaimsFromCtx[Session](ctx)
-	if session == (Session{}) {
+	if session.APIKeyID != 0 {
+		// API keys are checked when resolving them
+		return session, true
+	} else if session.AccountID == 0 || !s.checkSessionAccount(ctx, session) {
 		return Session{}, false
 	}
 
-	if len(accountType) >= 1 && session.AccountType != accountType[0] {
-		return Session{}, false
+	return session, true
+}
+
+// checkSessionAccount checks that the account of the session still exists, using the cache if possible.
+func (s *Service) checkSessionAccount(ctx context.Context, session Session) bool {
+	if accountType, ok := s.caches.account(session.AccountID); ok && accountType == session.AccountType {
+		return true
 	}
 
+	version := s.caches.accounts.Version()
+
 	exists, err := s.db.CheckAccountExists(ctx, session.AccountID, session.AccountType)
 	if err != nil {
 		s.logger.Error("failed to check session account in db",
@@ -126,10 +399,27 @@ func (s *Service) authorizeSession(ctx context.Context, accountType ...storage.A
 			"account_type", session.AccountType,
 			"error", err,
 		)
-		return Session{}, false
+		return false
 	} else if !exists {
-		return Session{}, false
+		return false
 	}
 
-	return session, true
+	s.caches.accounts.SetIfVersion(session.AccountID, session.AccountType, version)
+	return true
+}
+
+// clientInfoFromCtx returns the user agent and IP address of the client which initiated the request.
+func clientInfoFromCtx(ctx context.Context) (device string, ipAddress string) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		device = strings.Join(md.Get("user-agent"), " ")
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		ipAddress = p.Addr.String()
+		if host, _, err := net.SplitHostPort(ipAddress); err == nil {
+			ipAddress = host
+		}
+	}
+
+	return device, ipAddress
 }
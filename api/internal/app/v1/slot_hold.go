@@ -0,0 +1,87 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ldt-hack/api/internal/assignment"
+	"ldt-hack/api/internal/crypto"
+	desc "ldt-hack/api/internal/pb/app/v1"
+	"ldt-hack/api/internal/storage"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// HoldConsultationSlot implements the consultation slot hold endpoint.
+func (s *Service) HoldConsultationSlot(ctx context.Context, req *desc.HoldConsultationSlotRequest) (*desc.HoldConsultationSlotResponse, error) {
+	session, authorized := s.authorizeSession(ctx)
+	if !authorized {
+		return nil, errUnauthorized
+	}
+
+	if err := s.requireVerifiedEmail(ctx, session.AccountID); err != nil {
+		return nil, err
+	}
+
+	businessUser, err := s.db.GetBusinessUser(ctx, session.AccountID)
+	if err != nil {
+		s.logger.Error("failed to get business user during consultation slot hold",
+			"account_id", session.AccountID,
+			"error", err,
+		)
+		return nil, errInternal
+	}
+
+	token, err := crypto.GenerateToken()
+	if err != nil {
+		s.logger.Error("failed to generate consultation slot hold token", "error", err)
+		return nil, errInternal
+	}
+
+	expiresAt := time.Now().Add(s.slotHoldTTL)
+
+	err = s.db.CreateSlotHold(ctx, req.TopicId, req.SlotId, businessUser.ID, crypto.HashToken(token), expiresAt, assignment.Assign)
+	if errors.Is(err, storage.ErrConsultationSlotExhausted) {
+		return nil, errSlotAlreadyTaken
+	} else if err != nil {
+		s.logger.Error("failed to create consultation slot hold in storage",
+			"topic_id", req.TopicId,
+			"slot_id", req.SlotId,
+			"business_user_id", businessUser.ID,
+			"error", err,
+		)
+		return nil, errInternal
+	}
+
+	return &desc.HoldConsultationSlotResponse{
+		HoldToken: token,
+		ExpiresAt: timestamppb.New(expiresAt),
+	}, nil
+}
+
+// SweepSlotHolds deletes the expired consultation slot holds at the interval until the context is canceled.
+// The slots freed up this way are offered to the business users on the waitlist.
+func (s *Service) SweepSlotHolds(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		deleted, err := s.db.DeleteExpiredSlotHolds(ctx)
+		if err != nil {
+			s.logger.Error("failed to delete expired consultation slot holds", "error", err)
+			continue
+		}
+
+		if deleted > 0 {
+			s.logger.Debug("deleted expired consultation slot holds", "count", deleted)
+			s.waitlist.Notify()
+		}
+	}
+}
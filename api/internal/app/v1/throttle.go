@@ -0,0 +1,183 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	// Failed login attempts are forgotten after this interval passes without any new failures
+	loginFailureResetInterval = time.Hour
+	// Base delay for the exponential backoff applied after the free attempts are used up
+	loginBackoffBase = time.Second
+
+	loginThrottledMessage         = "Слишком много неудачных попыток входа, повторите через %d с."
+	passwordResetThrottledMessage = "Слишком много запросов сброса пароля, повторите через %d с."
+)
+
+// loginThrottlePolicy describes how failed login attempts of a single key are throttled.
+type loginThrottlePolicy struct {
+	// Number of failures after which exponential backoff is applied
+	freeFailures int
+	// Number of failures after which the key is locked out
+	lockoutFailures int
+	maxBackoff      time.Duration
+	lockoutDuration time.Duration
+}
+
+var (
+	// Emails are throttled more strictly since they are targeted by credential stuffing
+	emailThrottlePolicy = loginThrottlePolicy{
+		freeFailures:    3,
+		lockoutFailures: 10,
+		maxBackoff:      time.Minute,
+		lockoutDuration: time.Minute * 15,
+	}
+	// IPs are allowed more failures since many users can share a single IP
+	ipThrottlePolicy = loginThrottlePolicy{
+		freeFailures:    10,
+		lockoutFailures: 50,
+		maxBackoff:      time.Minute,
+		lockoutDuration: time.Minute * 15,
+	}
+	// Every password reset request sends an email, so they are throttled regardless of whether they succeed
+	passwordResetEmailThrottlePolicy = loginThrottlePolicy{
+		freeFailures:    3,
+		lockoutFailures: 10,
+		maxBackoff:      time.Minute * 5,
+		lockoutDuration: time.Hour,
+	}
+	passwordResetIPThrottlePolicy = loginThrottlePolicy{
+		freeFailures:    10,
+		lockoutFailures: 50,
+		maxBackoff:      time.Minute,
+		lockoutDuration: time.Minute * 15,
+	}
+)
+
+// loginThrottleKeys returns the keys by which login attempts are throttled along with their policies.
+func loginThrottleKeys(ctx context.Context, email string) map[string]loginThrottlePolicy {
+	keys := map[string]loginThrottlePolicy{
+		"email:" + strings.ToLower(email): emailThrottlePolicy,
+	}
+
+	if _, ipAddress := clientInfoFromCtx(ctx); ipAddress != "" {
+		keys["ip:"+ipAddress] = ipThrottlePolicy
+	}
+
+	return keys
+}
+
+// passwordResetThrottleKeys returns the keys by which password reset requests are throttled along with their policies.
+// They are separate from the login keys so that requesting a password reset doesn't block logging in and vice versa.
+func passwordResetThrottleKeys(ctx context.Context, email string) map[string]loginThrottlePolicy {
+	keys := map[string]loginThrottlePolicy{
+		"password_reset:email:" + strings.ToLower(email): passwordResetEmailThrottlePolicy,
+	}
+
+	if _, ipAddress := clientInfoFromCtx(ctx); ipAddress != "" {
+		keys["password_reset:ip:"+ipAddress] = passwordResetIPThrottlePolicy
+	}
+
+	return keys
+}
+
+// checkLoginThrottle returns an error with the retry delay if logging in is currently blocked for any of the keys.
+func (s *Service) checkLoginThrottle(ctx context.Context, keys map[string]loginThrottlePolicy) error {
+	return s.checkThrottle(ctx, keys, loginThrottledMessage)
+}
+
+// checkThrottle returns an error with the message formatted with the retry delay in seconds
+// if the action is currently blocked for any of the keys.
+func (s *Service) checkThrottle(ctx context.Context, keys map[string]loginThrottlePolicy, message string) error {
+	keyList := make([]string, 0, len(keys))
+	for key := range keys {
+		keyList = append(keyList, key)
+	}
+
+	blockedUntil, err := s.db.GetLoginBlockedUntil(ctx, keyList)
+	if err != nil {
+		s.logger.Error("failed to check login throttle in db", "keys", keyList, "error", err)
+		return errInternal
+	} else if blockedUntil == nil {
+		return nil
+	}
+
+	return throttledError(message, time.Until(*blockedUntil))
+}
+
+// checkPasswordResetThrottle returns an error with the retry delay
+// if requesting a password reset is currently blocked for any of the keys.
+func (s *Service) checkPasswordResetThrottle(ctx context.Context, keys map[string]loginThrottlePolicy) error {
+	return s.checkThrottle(ctx, keys, passwordResetThrottledMessage)
+}
+
+// recordLoginFailure records a failed login attempt for all of the login keys.
+func (s *Service) recordLoginFailure(ctx context.Context, keys map[string]loginThrottlePolicy) {
+	s.recordThrottledAttempt(ctx, keys)
+}
+
+// recordPasswordResetRequest records a password reset request for all of the password reset keys,
+// which is throttled regardless of whether it succeeds since every request sends an email.
+func (s *Service) recordPasswordResetRequest(ctx context.Context, keys map[string]loginThrottlePolicy) {
+	s.recordThrottledAttempt(ctx, keys)
+}
+
+// recordThrottledAttempt records an attempt for all of the keys, blocking them according to their policies if needed.
+func (s *Service) recordThrottledAttempt(ctx context.Context, keys map[string]loginThrottlePolicy) {
+	for key, policy := range keys {
+		failures, err := s.db.RecordLoginFailure(ctx, key, time.Now().Add(-loginFailureResetInterval))
+		if err != nil {
+			s.logger.Error("failed to record throttled attempt in db", "key", key, "error", err)
+			continue
+		}
+
+		if failures >= policy.lockoutFailures {
+			if err := s.db.LockoutLogin(ctx, key, failures, time.Now().Add(policy.lockoutDuration)); err != nil {
+				s.logger.Error("failed to lockout key in db", "key", key, "error", err)
+			} else {
+				s.logger.Warn("key locked out due to too many attempts", "key", key, "attempts", failures)
+			}
+		} else if failures >= policy.freeFailures {
+			backoff := loginBackoffBase * time.Duration(math.Pow(2, float64(failures-policy.freeFailures)))
+			if backoff > policy.maxBackoff {
+				backoff = policy.maxBackoff
+			}
+
+			if err := s.db.BlockLogin(ctx, key, time.Now().Add(backoff)); err != nil {
+				s.logger.Error("failed to block key in db", "key", key, "error", err)
+			}
+		}
+	}
+}
+
+// resetLoginFailures forgets the previous failed login attempts for the email after a successful login.
+// IP failures aren't reset since a single valid account would then allow bypassing the throttling.
+func (s *Service) resetLoginFailures(ctx context.Context, email string) {
+	key := "email:" + strings.ToLower(email)
+	if err := s.db.ResetLoginFailures(ctx, key); err != nil {
+		s.logger.Error("failed to reset login failures in db", "key", key, "error", err)
+	}
+}
+
+func throttledError(message string, retryDelay time.Duration) error {
+	retryDelay = retryDelay.Round(time.Second)
+	if retryDelay < time.Second {
+		retryDelay = time.Second
+	}
+
+	st := status.New(codes.ResourceExhausted, fmt.Sprintf(message, int(retryDelay.Seconds())))
+	if detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryDelay)}); err == nil {
+		st = detailed
+	}
+
+	return st.Err()
+}
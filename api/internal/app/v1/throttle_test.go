@@ -0,0 +1,45 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Requesting password resets must not lock the user out of logging in, so the keys must never overlap.
+func TestPasswordResetThrottleKeysAreSeparate(t *testing.T) {
+	loginKeys := loginThrottleKeys(context.Background(), "User@Example.com")
+	resetKeys := passwordResetThrottleKeys(context.Background(), "User@Example.com")
+
+	if _, ok := resetKeys["password_reset:email:user@example.com"]; !ok {
+		t.Errorf("reset keys %v aren't throttled by the normalized email", resetKeys)
+	}
+
+	for key := range resetKeys {
+		if _, ok := loginKeys[key]; ok {
+			t.Errorf("key %s is shared by logins and password resets", key)
+		}
+	}
+}
+
+func TestThrottledError(t *testing.T) {
+	st := status.Convert(throttledError(passwordResetThrottledMessage, time.Millisecond*1500))
+
+	if st.Code() != codes.ResourceExhausted {
+		t.Errorf("code = %v, want %v", st.Code(), codes.ResourceExhausted)
+	}
+	if want := "Слишком много запросов сброса пароля, повторите через 2 с."; st.Message() != want {
+		t.Errorf("message = %q, want %q", st.Message(), want)
+	}
+
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok && info.RetryDelay.AsDuration() == time.Second*2 {
+			return
+		}
+	}
+	t.Errorf("details %v don't contain the retry delay", st.Details())
+}
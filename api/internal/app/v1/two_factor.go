@@ -0,0 +1,320 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"ldt-hack/api/internal/auth"
+	"ldt-hack/api/internal/crypto"
+	desc "ldt-hack/api/internal/pb/app/v1"
+	"ldt-hack/api/internal/storage"
+
+	"github.com/go-jose/go-jose/v3/jwt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Partial tokens only need to live long enough for the user to enter the code or enroll
+	partialTokenExpiry = time.Minute * 10
+	recoveryCodeCount  = 10
+	// Issuer displayed in authenticator apps
+	totpIssuer = "LDT Hack"
+)
+
+var (
+	errTwoFactorInvalidCode    = status.Error(codes.InvalidArgument, "Указан неверный или уже использованный код")
+	errTwoFactorNotSetUp       = status.Error(codes.FailedPrecondition, "Двухфакторная аутентификация не настроена")
+	errTwoFactorAlreadyEnabled = status.Error(codes.FailedPrecondition, "Двухфакторная аутентификация уже включена")
+	errTwoFactorRequired       = status.Error(codes.FailedPrecondition, "Двухфакторная аутентификация обязательна для вашего ведомства")
+	errTwoFactorNotPartial     = status.Error(codes.FailedPrecondition, "Вход уже выполнен")
+)
+
+// SetupTwoFactor implements the TOTP enrollment start endpoint.
+func (s *Service) SetupTwoFactor(ctx context.Context, _ *emptypb.Empty) (*desc.SetupTwoFactorResponse, error) {
+	session, authorized := s.authorizeSession(ctx)
+	if !authorized {
+		return nil, errUnauthorized
+	}
+
+	account, err := s.db.GetAccountByID(ctx, session.AccountID)
+	if err != nil {
+		s.logger.Error("failed to get account for two-factor setup", "account_id", session.AccountID, "error", err)
+		return nil, errInternal
+	}
+
+	secret, err := crypto.GenerateTOTPSecret()
+	if err != nil {
+		s.logger.Error("failed to generate totp secret", "error", err)
+		return nil, errInternal
+	}
+
+	if err := s.db.CreateAccountTOTP(ctx, session.AccountID, secret); errors.Is(err, storage.ErrAlreadyExists) {
+		return nil, errTwoFactorAlreadyEnabled
+	} else if err != nil {
+		s.logger.Error("failed to create totp enrollment in db", "account_id", session.AccountID, "error", err)
+		return nil, errInternal
+	}
+
+	return &desc.SetupTwoFactorResponse{
+		Secret:          crypto.EncodeTOTPSecret(secret),
+		ProvisioningUri: crypto.TOTPProvisioningURI(totpIssuer, account.Email, secret),
+	}, nil
+}
+
+// EnableTwoFactor implements the TOTP enrollment confirmation endpoint.
+func (s *Service) EnableTwoFactor(ctx context.Context, req *desc.EnableTwoFactorRequest) (*desc.TwoFactorRecoveryCodes, error) {
+	session, authorized := s.authorizeSession(ctx)
+	if !authorized {
+		return nil, errUnauthorized
+	}
+
+	throttleKeys := twoFactorThrottleKeys(session.AccountID)
+	if err := s.checkLoginThrottle(ctx, throttleKeys); err != nil {
+		return nil, err
+	}
+
+	totp, err := s.db.GetAccountTOTP(ctx, session.AccountID)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, errTwoFactorNotSetUp
+	} else if err != nil {
+		s.logger.Error("failed to get totp enrollment from db", "account_id", session.AccountID, "error", err)
+		return nil, errInternal
+	} else if totp.EnabledAt != nil {
+		return nil, errTwoFactorAlreadyEnabled
+	}
+
+	step, ok := crypto.ValidateTOTP(totp.Secret, req.Code, time.Now())
+	if !ok {
+		s.recordLoginFailure(ctx, throttleKeys)
+		return nil, errTwoFactorInvalidCode
+	}
+
+	recoveryCodes, recoveryCodeHashes, err := generateRecoveryCodes()
+	if err != nil {
+		s.logger.Error("failed to generate recovery codes", "error", err)
+		return nil, errInternal
+	}
+
+	err = s.db.EnableAccountTOTP(ctx, session.AccountID, step, recoveryCodeHashes, session.TokenID)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, errTwoFactorNotSetUp
+	} else if err != nil {
+		s.logger.Error("failed to enable totp in db", "account_id", session.AccountID, "error", err)
+		return nil, errInternal
+	}
+
+	s.resetTwoFactorFailures(ctx, session.AccountID)
+
+	return &desc.TwoFactorRecoveryCodes{RecoveryCodes: recoveryCodes}, nil
+}
+
+// DisableTwoFactor implements the two-factor authentication disabling endpoint.
+func (s *Service) DisableTwoFactor(ctx context.Context, req *desc.DisableTwoFactorRequest) (*emptypb.Empty, error) {
+	session, authorized := s.authorizeSession(ctx)
+	if !authorized {
+		return nil, errUnauthorized
+	}
+
+	twoFactorStatus, err := s.db.GetTwoFactorStatus(ctx, session.AccountID)
+	if err != nil {
+		s.logger.Error("failed to get two-factor status from db", "account_id", session.AccountID, "error", err)
+		return nil, errInternal
+	} else if twoFactorStatus.Required {
+		return nil, errTwoFactorRequired
+	} else if !twoFactorStatus.Enabled {
+		return nil, errTwoFactorNotSetUp
+	}
+
+	if _, err := s.checkCurrentPassword(ctx, session.AccountID, req.Password); err != nil {
+		return nil, err
+	} else if err := s.checkSecondFactor(ctx, session.AccountID, req.Code); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.DeleteAccountTOTP(ctx, session.AccountID); err != nil {
+		s.logger.Error("failed to delete totp from db", "account_id", session.AccountID, "error", err)
+		return nil, errInternal
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// RegenerateRecoveryCodes implements the recovery codes regeneration endpoint.
+func (s *Service) RegenerateRecoveryCodes(ctx context.Context, req *desc.RegenerateRecoveryCodesRequest,
+) (*desc.TwoFactorRecoveryCodes, error) {
+	session, authorized := s.authorizeSession(ctx)
+	if !authorized {
+		return nil, errUnauthorized
+	}
+
+	if err := s.checkSecondFactor(ctx, session.AccountID, req.Code); err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, recoveryCodeHashes, err := generateRecoveryCodes()
+	if err != nil {
+		s.logger.Error("failed to generate recovery codes", "error", err)
+		return nil, errInternal
+	}
+
+	if err := s.db.ReplaceRecoveryCodes(ctx, session.AccountID, recoveryCodeHashes); err != nil {
+		s.logger.Error("failed to replace recovery codes in db", "account_id", session.AccountID, "error", err)
+		return nil, errInternal
+	}
+
+	return &desc.TwoFactorRecoveryCodes{RecoveryCodes: recoveryCodes}, nil
+}
+
+// VerifyTwoFactor implements the second step of the login for users with two-factor authentication.
+func (s *Service) VerifyTwoFactor(ctx context.Context, req *desc.VerifyTwoFactorRequest) (*desc.SessionToken, error) {
+	session, authorized := s.authorizeSession(ctx)
+	if !authorized {
+		return nil, errUnauthorized
+	} else if !session.Partial {
+		return nil, errTwoFactorNotPartial
+	}
+
+	if err := s.checkSecondFactor(ctx, session.AccountID, req.Code); err != nil {
+		return nil, err
+	}
+
+	// Get the account again since the role might have been changed after the partial token was issued
+	account, err := s.db.GetAccountByID(ctx, session.AccountID)
+	if err != nil {
+		s.logger.Error("failed to get account for two-factor login", "account_id", session.AccountID, "error", err)
+		return nil, errInternal
+	}
+
+	token := s.constructSession(ctx, "login", account)
+	if token == nil {
+		return nil, errInternal
+	}
+
+	return token, nil
+}
+
+// twoFactorStep returns the second step which must be completed by the account to log in, if any.
+func (s *Service) twoFactorStep(ctx context.Context, account storage.Account) (desc.TwoFactorStep, error) {
+	if account.Type != storage.AccountTypeAuthority {
+		return desc.TwoFactorStep_TWO_FACTOR_STEP_NONE, nil
+	}
+
+	twoFactorStatus, err := s.db.GetTwoFactorStatus(ctx, account.ID)
+	if err != nil {
+		s.logger.Error("failed to get two-factor status from db", "account_id", account.ID, "error", err)
+		return 0, errInternal
+	}
+
+	if twoFactorStatus.Enabled {
+		return desc.TwoFactorStep_TWO_FACTOR_STEP_VERIFY, nil
+	} else if twoFactorStatus.Required {
+		return desc.TwoFactorStep_TWO_FACTOR_STEP_ENROLL, nil
+	}
+
+	return desc.TwoFactorStep_TWO_FACTOR_STEP_NONE, nil
+}
+
+// signPartialSession constructs a partial token which only allows completing the specified second step.
+// No server-side session is created until the step is completed.
+func (s *Service) signPartialSession(account storage.Account, step desc.TwoFactorStep) *desc.SessionToken {
+	permissions := []auth.Permission{PermissionVerifyTwoFactor}
+	if step == desc.TwoFactorStep_TWO_FACTOR_STEP_ENROLL {
+		permissions = append(permissions, PermissionManageTwoFactor)
+	}
+
+	expiresAt := time.Now().Add(partialTokenExpiry)
+
+	token, err := s.authorizer.Construct(Session{
+		AccountID:   account.ID,
+		AccountType: account.Type,
+		Role:        account.Role,
+		Permissions: permissions,
+		Partial:     true,
+		Expiry:      jwt.NewNumericDate(expiresAt),
+	})
+	if err != nil {
+		s.logger.Error("failed to construct partial user token", "account_id", account.ID, "error", err)
+		return nil
+	}
+
+	return &desc.SessionToken{
+		Token:         token,
+		ExpiresAt:     timestamppb.New(expiresAt),
+		TwoFactorStep: step,
+	}
+}
+
+// checkSecondFactor checks a code from the authenticator app or a recovery code, marking it as used.
+// Failed attempts are throttled in the same way as failed logins.
+func (s *Service) checkSecondFactor(ctx context.Context, accountID int64, code string) error {
+	throttleKeys := twoFactorThrottleKeys(accountID)
+	if err := s.checkLoginThrottle(ctx, throttleKeys); err != nil {
+		return err
+	}
+
+	totp, err := s.db.GetAccountTOTP(ctx, accountID)
+	if errors.Is(err, storage.ErrNotFound) {
+		return errTwoFactorNotSetUp
+	} else if err != nil {
+		s.logger.Error("failed to get totp from db", "account_id", accountID, "error", err)
+		return errInternal
+	} else if totp.EnabledAt == nil {
+		return errTwoFactorNotSetUp
+	}
+
+	if step, ok := crypto.ValidateTOTP(totp.Secret, code, time.Now()); ok {
+		err = s.db.UseTOTPStep(ctx, accountID, step)
+	} else {
+		err = s.db.UseRecoveryCode(ctx, accountID, crypto.HashToken(crypto.NormalizeRecoveryCode(code)))
+	}
+
+	if errors.Is(err, storage.ErrNotFound) {
+		s.recordLoginFailure(ctx, throttleKeys)
+		return errTwoFactorInvalidCode
+	} else if err != nil {
+		s.logger.Error("failed to use second factor code in db", "account_id", accountID, "error", err)
+		return errInternal
+	}
+
+	s.resetTwoFactorFailures(ctx, accountID)
+	return nil
+}
+
+// twoFactorThrottleKeys returns the keys by which code checks are throttled. The partial token
+// already proves the knowledge of the password, so only the account itself is throttled.
+func twoFactorThrottleKeys(accountID int64) map[string]loginThrottlePolicy {
+	return map[string]loginThrottlePolicy{
+		"two_factor:" + strconv.FormatInt(accountID, 10): emailThrottlePolicy,
+	}
+}
+
+func (s *Service) resetTwoFactorFailures(ctx context.Context, accountID int64) {
+	for key := range twoFactorThrottleKeys(accountID) {
+		if err := s.db.ResetLoginFailures(ctx, key); err != nil {
+			s.logger.Error("failed to reset two-factor failures in db", "key", key, "error", err)
+		}
+	}
+}
+
+// generateRecoveryCodes generates new recovery codes along with their hashes for storage.
+func generateRecoveryCodes() ([]string, [][]byte, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([][]byte, recoveryCodeCount)
+
+	for i := range codes {
+		code, err := crypto.GenerateRecoveryCode()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		codes[i] = code
+		hashes[i] = crypto.HashToken(crypto.NormalizeRecoveryCode(code))
+	}
+
+	return codes, hashes, nil
+}
@@ -14,24 +14,18 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
-const (
-	minPasswordLen = 3
-	maxPasswordLen = 50
-)
-
 var (
-	errCreateInvalidEmail    = status.Error(codes.InvalidArgument, "Укажите корректный почтовый адрес")
-	errCreateInvalidPassword = status.Error(codes.InvalidArgument, "Пароль должен быть длиной от 3 до 50 символов")
-	errCreateEmailTaken      = status.Error(codes.AlreadyExists, "Указанный почтовый адрес уже используется, попробуйте с ним войти")
-	errUserUnknownSex        = status.Error(codes.InvalidArgument, "Указан неизвестный пол")
+	errCreateInvalidEmail = status.Error(codes.InvalidArgument, "Укажите корректный почтовый адрес")
+	errCreateEmailTaken   = status.Error(codes.AlreadyExists, "Указанный почтовый адрес уже используется, попробуйте с ним войти")
+	errUserUnknownSex     = status.Error(codes.InvalidArgument, "Указан неизвестный пол")
 )
 
 // CreateBusinessUser implements the business user creation endpoint.
 func (s *Service) CreateBusinessUser(ctx context.Context, req *desc.CreateBusinessUserRequest) (*desc.SessionToken, error) {
 	if !govalidator.IsEmail(req.Email) {
 		return nil, errCreateInvalidEmail
-	} else if len(req.Password) < minPasswordLen || len(req.Password) > maxPasswordLen {
-		return nil, errCreateInvalidPassword
+	} else if err := s.validatePassword(req.Password); err != nil {
+		return nil, err
 	} else if err := validateBusinessUserFields(req.User); err != nil {
 		return nil, err
 	}
@@ -51,7 +45,16 @@ func (s *Service) CreateBusinessUser(ctx context.Context, req *desc.CreateBusine
 		return nil, errInternal
 	}
 
-	session := s.constructSession("creation", accountID, storage.AccountTypeBusiness)
+	if err := s.sendEmailVerification(ctx, accountID, req.Email); err != nil {
+		// Not critical since the code can be resent later
+		s.logger.Error("failed to send email verification after creation", "account_id", accountID, "error", err)
+	}
+
+	session := s.constructSession(ctx, "creation", storage.Account{
+		ID:   accountID,
+		Type: storage.AccountTypeBusiness,
+		Role: storage.AccountRoleBusinessOwner,
+	})
 	if session == nil {
 		return nil, errInternal
 	}
@@ -61,7 +64,7 @@ func (s *Service) CreateBusinessUser(ctx context.Context, req *desc.CreateBusine
 
 // UpdateBusinessUser implements the endpoint for updating a business user's information.
 func (s *Service) UpdateBusinessUser(ctx context.Context, req *desc.UpdateBusinessUserRequest) (*emptypb.Empty, error) {
-	session, authorized := s.authorizeSession(ctx, storage.AccountTypeBusiness)
+	session, authorized := s.authorizeSession(ctx)
 	if !authorized {
 		return nil, errUnauthorized
 	}
@@ -83,7 +86,7 @@ func (s *Service) UpdateBusinessUser(ctx context.Context, req *desc.UpdateBusine
 
 // DeleteBusinessUser implements the endpoint for deleting a business user's account.
 func (s *Service) DeleteBusinessUser(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
-	session, authorized := s.authorizeSession(ctx, storage.AccountTypeBusiness)
+	session, authorized := s.authorizeSession(ctx)
 	if !authorized {
 		return nil, errUnauthorized
 	}
@@ -93,9 +96,22 @@ func (s *Service) DeleteBusinessUser(ctx context.Context, _ *emptypb.Empty) (*em
 		return nil, errInternal
 	}
 
+	// Other instances are notified by the database, but this one must not accept the account's tokens even briefly
+	s.caches.accounts.Delete(session.AccountID)
+	s.caches.sessions.Delete(session.TokenID)
+
 	return &emptypb.Empty{}, nil
 }
 
+// validatePassword checks that a new password satisfies the password policy.
+func (s *Service) validatePassword(password string) error {
+	if err := s.passwords.Validate(password); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return nil
+}
+
 func validateBusinessUserFields(user *desc.BusinessUser) error {
 	if user == nil ||
 		user.FirstName == "" ||
@@ -0,0 +1,115 @@
+package assignment
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"ldt-hack/api/internal/storage"
+
+	"github.com/samber/lo"
+)
+
+const timeLayout = "02.01.2006 15:04"
+
+// Strategy chooses one of the non-empty list of candidates for an appointment
+// and explains the choice in a human-readable form.
+type Strategy interface {
+	Choose(candidates []storage.AssignmentCandidate) (storage.AssignmentCandidate, string)
+}
+
+var strategies = map[storage.AssignmentStrategy]Strategy{
+	storage.AssignmentStrategyRandom:            random{},
+	storage.AssignmentStrategyLeastLoaded:       leastLoaded{},
+	storage.AssignmentStrategyRoundRobin:        roundRobin{},
+	storage.AssignmentStrategyPreviousInspector: previousInspector{},
+}
+
+// Valid reports whether the strategy is known.
+func Valid(strategy storage.AssignmentStrategy) bool {
+	_, ok := strategies[strategy]
+	return ok
+}
+
+// Assign chooses an inspector for an appointment using the strategy, falling back to the random one for unknown strategies.
+// It implements storage.Assigner. No inspector is chosen if there are no candidates, instead of failing in the strategy.
+func Assign(strategy storage.AssignmentStrategy, candidates []storage.AssignmentCandidate) storage.Assignment {
+	s, ok := strategies[strategy]
+	if !ok {
+		strategy = storage.AssignmentStrategyRandom
+		s = strategies[strategy]
+	}
+
+	if len(candidates) == 0 {
+		return storage.Assignment{Strategy: strategy}
+	}
+
+	chosen, reason := s.Choose(candidates)
+	return storage.Assignment{
+		Inspector: chosen.Inspector,
+		Strategy:  strategy,
+		Reason:    reason,
+	}
+}
+
+type random struct{}
+
+func (random) Choose(candidates []storage.AssignmentCandidate) (storage.AssignmentCandidate, string) {
+	chosen := candidates[rand.Intn(len(candidates))]
+	return chosen, fmt.Sprintf("выбран случайно из %d доступных инспекторов", len(candidates))
+}
+
+// leastLoaded chooses the inspector with the least appointments around the slot,
+// preferring the one who was assigned the longest time ago in case of a tie.
+type leastLoaded struct{}
+
+func (leastLoaded) Choose(candidates []storage.AssignmentCandidate) (storage.AssignmentCandidate, string) {
+	chosen := lo.MinBy(candidates, func(a, b storage.AssignmentCandidate) bool {
+		return a.Load < b.Load || (a.Load == b.Load && assignedEarlier(a, b))
+	})
+
+	return chosen, fmt.Sprintf("наименьшая загрузка среди %d доступных инспекторов: %d записей в пределах %d дней от консультации",
+		len(candidates), chosen.Load, int(storage.AssignmentLoadWindow/(time.Hour*24)))
+}
+
+// roundRobin chooses the inspector who was assigned the longest time ago, so that the inspectors take turns.
+type roundRobin struct{}
+
+func (roundRobin) Choose(candidates []storage.AssignmentCandidate) (storage.AssignmentCandidate, string) {
+	chosen := lo.MinBy(candidates, assignedEarlier)
+
+	if chosen.LastAssignedAt == nil {
+		return chosen, "очередь: инспектору еще не назначались консультации"
+	}
+	return chosen, fmt.Sprintf("очередь: последнее назначение инспектору было дольше всех, %s",
+		chosen.LastAssignedAt.UTC().Format(timeLayout))
+}
+
+// previousInspector chooses the inspector who has most recently consulted the business user,
+// falling back to the least loaded inspector if none of the candidates have.
+type previousInspector struct{}
+
+func (previousInspector) Choose(candidates []storage.AssignmentCandidate) (storage.AssignmentCandidate, string) {
+	served := lo.Filter(candidates, func(c storage.AssignmentCandidate, _ int) bool {
+		return c.LastServedAt != nil
+	})
+
+	if len(served) == 0 {
+		chosen, reason := leastLoaded{}.Choose(candidates)
+		return chosen, "ни один из доступных инспекторов ранее не консультировал пользователя, " + reason
+	}
+
+	chosen := lo.MaxBy(served, func(a, b storage.AssignmentCandidate) bool {
+		return a.LastServedAt.After(*b.LastServedAt)
+	})
+	return chosen, fmt.Sprintf("инспектор ранее консультировал пользователя, последняя консультация %s",
+		chosen.LastServedAt.UTC().Format(timeLayout))
+}
+
+// assignedEarlier reports whether the candidate a was last assigned before b, with the never assigned candidates first.
+func assignedEarlier(a, b storage.AssignmentCandidate) bool {
+	if a.LastAssignedAt == nil || b.LastAssignedAt == nil {
+		return a.LastAssignedAt == nil && b.LastAssignedAt != nil
+	}
+	return a.LastAssignedAt.Before(*b.LastAssignedAt)
+}
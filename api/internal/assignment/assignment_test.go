@@ -0,0 +1,198 @@
+package assignment
+
+import (
+	"testing"
+	"time"
+
+	"ldt-hack/api/internal/storage"
+)
+
+var base = time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+func hoursAgo(hours int) *time.Time {
+	t := base.Add(-time.Duration(hours) * time.Hour)
+	return &t
+}
+
+func candidate(inspectorID int64, load int, lastAssignedAt, lastServedAt *time.Time) storage.AssignmentCandidate {
+	return storage.AssignmentCandidate{
+		Inspector:      storage.InspectorUser{ID: inspectorID},
+		Load:           load,
+		LastAssignedAt: lastAssignedAt,
+		LastServedAt:   lastServedAt,
+	}
+}
+
+func TestStrategies(t *testing.T) {
+	tests := []struct {
+		name       string
+		strategy   storage.AssignmentStrategy
+		candidates []storage.AssignmentCandidate
+		want       int64
+	}{
+		{
+			name:       "least loaded single",
+			strategy:   storage.AssignmentStrategyLeastLoaded,
+			candidates: []storage.AssignmentCandidate{candidate(1, 5, nil, nil)},
+			want:       1,
+		},
+		{
+			name:     "least loaded",
+			strategy: storage.AssignmentStrategyLeastLoaded,
+			candidates: []storage.AssignmentCandidate{
+				candidate(1, 3, hoursAgo(10), nil),
+				candidate(2, 1, hoursAgo(1), nil),
+				candidate(3, 2, nil, nil),
+			},
+			want: 2,
+		},
+		{
+			name:     "least loaded tie prefers assigned earlier",
+			strategy: storage.AssignmentStrategyLeastLoaded,
+			candidates: []storage.AssignmentCandidate{
+				candidate(1, 1, hoursAgo(1), nil),
+				candidate(2, 1, hoursAgo(5), nil),
+				candidate(3, 2, hoursAgo(10), nil),
+			},
+			want: 2,
+		},
+		{
+			name:     "least loaded tie prefers never assigned",
+			strategy: storage.AssignmentStrategyLeastLoaded,
+			candidates: []storage.AssignmentCandidate{
+				candidate(1, 0, hoursAgo(5), nil),
+				candidate(2, 0, nil, nil),
+			},
+			want: 2,
+		},
+		{
+			name:     "least loaded full tie keeps order",
+			strategy: storage.AssignmentStrategyLeastLoaded,
+			candidates: []storage.AssignmentCandidate{
+				candidate(1, 0, nil, nil),
+				candidate(2, 0, nil, nil),
+			},
+			want: 1,
+		},
+		{
+			name:     "round robin",
+			strategy: storage.AssignmentStrategyRoundRobin,
+			candidates: []storage.AssignmentCandidate{
+				candidate(1, 0, hoursAgo(1), nil),
+				candidate(2, 5, hoursAgo(24), nil),
+				candidate(3, 0, hoursAgo(2), nil),
+			},
+			want: 2,
+		},
+		{
+			name:     "round robin prefers never assigned",
+			strategy: storage.AssignmentStrategyRoundRobin,
+			candidates: []storage.AssignmentCandidate{
+				candidate(1, 0, hoursAgo(24), nil),
+				candidate(2, 0, nil, nil),
+			},
+			want: 2,
+		},
+		{
+			name:     "round robin tie keeps order",
+			strategy: storage.AssignmentStrategyRoundRobin,
+			candidates: []storage.AssignmentCandidate{
+				candidate(1, 0, hoursAgo(3), nil),
+				candidate(2, 0, hoursAgo(3), nil),
+			},
+			want: 1,
+		},
+		{
+			name:     "previous inspector",
+			strategy: storage.AssignmentStrategyPreviousInspector,
+			candidates: []storage.AssignmentCandidate{
+				candidate(1, 0, nil, nil),
+				candidate(2, 9, hoursAgo(1), hoursAgo(48)),
+				candidate(3, 9, hoursAgo(1), hoursAgo(24)),
+			},
+			want: 3,
+		},
+		{
+			name:     "previous inspector tie keeps order",
+			strategy: storage.AssignmentStrategyPreviousInspector,
+			candidates: []storage.AssignmentCandidate{
+				candidate(1, 0, nil, nil),
+				candidate(2, 0, nil, hoursAgo(24)),
+				candidate(3, 0, nil, hoursAgo(24)),
+			},
+			want: 2,
+		},
+		{
+			name:     "previous inspector falls back to least loaded",
+			strategy: storage.AssignmentStrategyPreviousInspector,
+			candidates: []storage.AssignmentCandidate{
+				candidate(1, 2, nil, nil),
+				candidate(2, 1, hoursAgo(1), nil),
+				candidate(3, 1, hoursAgo(2), nil),
+			},
+			want: 3,
+		},
+		{
+			name:       "random single",
+			strategy:   storage.AssignmentStrategyRandom,
+			candidates: []storage.AssignmentCandidate{candidate(7, 0, nil, nil)},
+			want:       7,
+		},
+		{
+			name:       "unknown falls back to random",
+			strategy:   "unknown",
+			candidates: []storage.AssignmentCandidate{candidate(7, 0, nil, nil)},
+			want:       7,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assignment := Assign(tt.strategy, tt.candidates)
+			if assignment.Inspector.ID != tt.want {
+				t.Errorf("chosen inspector = %d, want %d", assignment.Inspector.ID, tt.want)
+			} else if assignment.Reason == "" {
+				t.Error("assignment has no reason")
+			}
+
+			wantStrategy := tt.strategy
+			if !Valid(wantStrategy) {
+				wantStrategy = storage.AssignmentStrategyRandom
+			}
+			if assignment.Strategy != wantStrategy {
+				t.Errorf("strategy = %q, want %q", assignment.Strategy, wantStrategy)
+			}
+		})
+	}
+}
+
+func TestRandomChoosesEveryCandidate(t *testing.T) {
+	candidates := []storage.AssignmentCandidate{candidate(1, 0, nil, nil), candidate(2, 0, nil, nil), candidate(3, 0, nil, nil)}
+
+	chosen := make(map[int64]int)
+	for i := 0; i < 1000; i++ {
+		chosen[Assign(storage.AssignmentStrategyRandom, candidates).Inspector.ID]++
+	}
+
+	for _, c := range candidates {
+		if chosen[c.Inspector.ID] == 0 {
+			t.Errorf("inspector %d was never chosen", c.Inspector.ID)
+		}
+	}
+
+	if len(chosen) != len(candidates) {
+		t.Errorf("chosen inspectors = %v, want only the candidates", chosen)
+	}
+}
+
+func TestAssignWithoutCandidates(t *testing.T) {
+	for strategy := range strategies {
+		t.Run(string(strategy), func(t *testing.T) {
+			for _, candidates := range [][]storage.AssignmentCandidate{nil, {}} {
+				if assignment := Assign(strategy, candidates); assignment.Inspector.ID != 0 {
+					t.Errorf("chosen inspector = %d, want none", assignment.Inspector.ID)
+				}
+			}
+		})
+	}
+}
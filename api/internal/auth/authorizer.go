@@ -4,10 +4,13 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"fmt"
+	"sync/atomic"
+	"time"
 
 	"github.com/go-jose/go-jose/v3"
 	"github.com/go-jose/go-jose/v3/jwt"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
+	"github.com/samber/lo"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -15,21 +18,48 @@ import (
 
 const (
 	bearerScheme  = "Bearer"
+	apiKeyScheme  = "ApiKey"
 	encrypterType = "JWT"
 )
 
-// Authorizer authorizes gRPC requests based on JWTs in the Authorization header.
+// Authorizer authorizes gRPC requests based on JWTs or API keys in the Authorization header.
 type Authorizer struct {
-	key       *ecdsa.PrivateKey
+	keyring atomic.Pointer[keyring]
+}
+
+// keyring contains everything needed to sign and validate tokens using a single key set,
+// so that it can be atomically swapped when the keys are rotated.
+type keyring struct {
+	keys      map[string]*ecdsa.PrivateKey
 	signer    jose.Signer
 	encrypter jose.Encrypter
 }
 
-// NewAuthorizer creates a new authorizer which encrypts, signs and validates tokens using an ecdsa private key.
-func NewAuthorizer(key *ecdsa.PrivateKey) (*Authorizer, error) {
-	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: key}, nil)
+// NewAuthorizer creates a new authorizer which encrypts, signs and validates tokens using ecdsa private keys.
+// New tokens are constructed using the signing key of the key set, and the ID of the key is specified in their headers.
+func NewAuthorizer(keys *KeySet) (*Authorizer, error) {
+	a := &Authorizer{}
+	if err := a.SetKeys(keys); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// SetKeys atomically replaces the key set used by the authorizer.
+// Tokens constructed using keys which are no longer present in the set won't be valid anymore.
+func (a *Authorizer) SetKeys(keys *KeySet) error {
+	key, err := keys.signingKey()
 	if err != nil {
-		return nil, fmt.Errorf("creating signer: %w", err)
+		return err
+	}
+
+	signer, err := jose.NewSigner(jose.SigningKey{
+		Algorithm: jose.ES256,
+		Key:       jose.JSONWebKey{Key: key, KeyID: keys.SigningKeyID},
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("creating signer: %w", err)
 	}
 
 	encrypter, err := jose.NewEncrypter(
@@ -37,38 +67,66 @@ func NewAuthorizer(key *ecdsa.PrivateKey) (*Authorizer, error) {
 		jose.Recipient{
 			Algorithm: jose.ECDH_ES_A256KW,
 			Key:       &key.PublicKey,
+			KeyID:     keys.SigningKeyID,
 		},
 		(&jose.EncrypterOptions{
 			Compression: jose.DEFLATE,
 		}).WithContentType(encrypterType).WithType(encrypterType))
 	if err != nil {
-		return nil, fmt.Errorf("creating encrypter: %w", err)
+		return fmt.Errorf("creating encrypter: %w", err)
 	}
 
-	return &Authorizer{key, signer, encrypter}, nil
+	a.keyring.Store(&keyring{keys: keys.Keys, signer: signer, encrypter: encrypter})
+	return nil
 }
 
-// UnaryInterceptor returns a unary gRPC interceptor which authorizes requests to all endpoints except the whitelisted ones.
-// The authorizer is used to validate incoming tokens, which are then decoded to the given type.
-func UnaryInterceptor[T any](a *Authorizer, whitelist ...string) grpc.UnaryServerInterceptor {
-	whitelistedEndpoints := make(map[string]struct{})
-	for _, endpoint := range whitelist {
-		whitelistedEndpoints[endpoint] = struct{}{}
-	}
+// Validator validates the claims of a cryptographically valid token,
+// allowing to reject tokens which have been revoked or are otherwise unusable.
+type Validator[T any] func(ctx context.Context, claims T) bool
 
+// KeyResolver resolves an API key into the claims granted to it, returning false if the key isn't valid.
+type KeyResolver[T any] func(ctx context.Context, key string) (T, bool)
+
+// UnaryInterceptor returns a unary gRPC interceptor which authorizes requests according to the per-method policies.
+// Methods without a policy can't be called at all. The authorizer is used to validate incoming tokens, which are then decoded
+// to the given type, validated using the validator, and checked to be granted the permissions required by the policy.
+// API keys passed using the ApiKey scheme instead of a token are resolved into claims using the key resolver, if one is given.
+func UnaryInterceptor[T Principal](a *Authorizer, validate Validator[T], resolveKey KeyResolver[T], policies map[string]Policy,
+) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-		if _, ok := whitelistedEndpoints[info.FullMethod]; ok {
+		policy, ok := policies[info.FullMethod]
+		if !ok {
+			return nil, status.Error(codes.PermissionDenied, "Method not allowed")
+		} else if policy.Public {
 			return handler(ctx, req)
 		}
 
-		tokenString, err := auth.AuthFromMD(ctx, bearerScheme)
-		if err != nil {
+		var claims T
+		if tokenString, err := auth.AuthFromMD(ctx, bearerScheme); err == nil {
+			if ok := a.VerifyAndParse(tokenString, &claims); !ok {
+				return nil, status.Error(codes.Unauthenticated, "Invalid token")
+			}
+
+			if ok := validate(ctx, claims); !ok {
+				return nil, status.Error(codes.Unauthenticated, "Revoked token")
+			}
+		} else if key, err := auth.AuthFromMD(ctx, apiKeyScheme); err == nil && resolveKey != nil {
+			var ok bool
+			if claims, ok = resolveKey(ctx, key); !ok {
+				return nil, status.Error(codes.Unauthenticated, "Invalid API key")
+			}
+		} else {
 			return nil, status.Error(codes.Unauthenticated, "Missing token")
 		}
 
-		var claims T
-		if ok := a.VerifyAndParse(tokenString, &claims); !ok {
-			return nil, status.Error(codes.Unauthenticated, "Invalid token")
+		if len(policy.AccountTypes) > 0 && !lo.ContainsBy(policy.AccountTypes, claims.HasAccountType) {
+			return nil, status.Error(codes.PermissionDenied, "Account type not allowed")
+		}
+
+		for _, permission := range policy.Permissions {
+			if !claims.HasPermission(permission) {
+				return nil, status.Error(codes.PermissionDenied, "Missing permission")
+			}
 		}
 
 		return handler(claimsToCtx(ctx, claims), req)
@@ -77,19 +135,37 @@ func UnaryInterceptor[T any](a *Authorizer, whitelist ...string) grpc.UnaryServe
 
 // VerifyAndParse verifies the given token and parses it into claims,
 //
-//	returning false if an error occurs on any step.
+//	returning false if an error occurs on any step. Tokens are required
+//	to contain an expiry ("exp" claim), which is validated as well.
 func (a *Authorizer) VerifyAndParse(token string, claims any) bool {
+	kr := a.keyring.Load()
+
 	encryptedJWT, err := jwt.ParseSignedAndEncrypted(token)
 	if err != nil {
 		return false
 	}
 
-	decryptedJWT, err := encryptedJWT.Decrypt(a.key)
-	if err != nil {
+	var decryptedJWT *jwt.JSONWebToken
+	for _, key := range kr.lookup(encryptedJWT.Headers) {
+		if decryptedJWT, err = encryptedJWT.Decrypt(key); err == nil {
+			break
+		}
+	}
+
+	if decryptedJWT == nil {
 		return false
 	}
 
-	if err := decryptedJWT.Claims(&a.key.PublicKey, claims); err != nil {
+	var registeredClaims jwt.Claims
+	var verified bool
+	for _, key := range kr.lookup(decryptedJWT.Headers) {
+		if decryptedJWT.Claims(&key.PublicKey, claims, &registeredClaims) == nil {
+			verified = true
+			break
+		}
+	}
+
+	if !verified || registeredClaims.Expiry == nil || registeredClaims.Validate(jwt.Expected{Time: time.Now()}) != nil {
 		return false
 	}
 
@@ -98,10 +174,29 @@ func (a *Authorizer) VerifyAndParse(token string, claims any) bool {
 
 // Construct constructs a new JWT containing the specified claims.
 func (a *Authorizer) Construct(claims any) (string, error) {
-	token, err := jwt.SignedAndEncrypted(a.signer, a.encrypter).Claims(claims).CompactSerialize()
+	kr := a.keyring.Load()
+
+	token, err := jwt.SignedAndEncrypted(kr.signer, kr.encrypter).Claims(claims).CompactSerialize()
 	if err != nil {
 		return "", fmt.Errorf("constructing token: %w", err)
 	}
 
 	return token, nil
 }
+
+// lookup returns the keys which can be used to process a token with the given headers.
+// Tokens constructed before key IDs were introduced don't specify one, so all of the keys are tried for them.
+func (kr *keyring) lookup(headers []jose.Header) []*ecdsa.PrivateKey {
+	var keyID string
+	if len(headers) > 0 {
+		keyID = headers[0].KeyID
+	}
+
+	if keyID == "" {
+		return lo.Values(kr.keys)
+	} else if key, ok := kr.keys[keyID]; ok {
+		return []*ecdsa.PrivateKey{key}
+	}
+
+	return nil
+}
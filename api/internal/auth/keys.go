@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const keyFileExt = ".pem"
+
+// KeySet is a set of ECDSA private keys identified by their key IDs.
+// The signing key is used for signing and encrypting new tokens, while all of the keys can be used for validation.
+type KeySet struct {
+	SigningKeyID string
+	Keys         map[string]*ecdsa.PrivateKey
+}
+
+// LoadKeySet loads a key set from the path, which can either point to a single PEM file or a directory of them.
+// Key IDs are the names of the files without the extension, and the key with the lexicographically greatest ID
+// is used as the signing key, so keys should be named in a sortable manner, for example, using their creation date.
+func LoadKeySet(path string) (*KeySet, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading JWT key path %s: %w", path, err)
+	}
+
+	paths := []string{path}
+	if info.IsDir() {
+		// Glob only fails on malformed patterns
+		paths, _ = filepath.Glob(filepath.Join(path, "*"+keyFileExt))
+	}
+
+	keys := make(map[string]*ecdsa.PrivateKey, len(paths))
+	for _, path := range paths {
+		key, err := readKey(path)
+		if err != nil {
+			return nil, err
+		}
+
+		keys[strings.TrimSuffix(filepath.Base(path), keyFileExt)] = key
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no JWT private keys found in %s", path)
+	}
+
+	keyIDs := make([]string, 0, len(keys))
+	for keyID := range keys {
+		keyIDs = append(keyIDs, keyID)
+	}
+	sort.Strings(keyIDs)
+
+	return &KeySet{SigningKeyID: keyIDs[len(keyIDs)-1], Keys: keys}, nil
+}
+
+func (ks *KeySet) signingKey() (*ecdsa.PrivateKey, error) {
+	key, ok := ks.Keys[ks.SigningKeyID]
+	if !ok {
+		return nil, errors.New("signing key missing from key set")
+	}
+
+	return key, nil
+}
+
+func readKey(path string) (*ecdsa.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading JWT private key from %s: %w", path, err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode JWT private key from %s as PEM", path)
+	}
+
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing JWT private key from %s as ECDSA private key: %w", path, err)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,250 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+)
+
+// writeKey generates a new key and writes it to the directory under the key ID.
+func writeKey(t *testing.T, dir, keyID string) *ecdsa.PrivateKey {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshaling key: %v", err)
+	}
+
+	writeFile(t, filepath.Join(dir, keyID+keyFileExt), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}))
+	return key
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("writing %s: %v", path, err)
+	}
+}
+
+func TestLoadKeySetDirectory(t *testing.T) {
+	dir := t.TempDir()
+	oldKey := writeKey(t, dir, "2023-01-01")
+	newKey := writeKey(t, dir, "2024-01-01")
+	middleKey := writeKey(t, dir, "2023-06-01")
+	writeFile(t, filepath.Join(dir, "README.txt"), []byte("not a key"))
+
+	keys, err := LoadKeySet(dir)
+	if err != nil {
+		t.Fatalf("loading key set: %v", err)
+	}
+
+	if keys.SigningKeyID != "2024-01-01" {
+		t.Errorf("signing key ID = %q, want the greatest one", keys.SigningKeyID)
+	}
+
+	want := map[string]*ecdsa.PrivateKey{"2023-01-01": oldKey, "2023-06-01": middleKey, "2024-01-01": newKey}
+	if len(keys.Keys) != len(want) {
+		t.Errorf("loaded %d keys, want %d", len(keys.Keys), len(want))
+	}
+
+	for keyID, key := range want {
+		if loaded, ok := keys.Keys[keyID]; !ok || !loaded.Equal(key) {
+			t.Errorf("key %q not loaded", keyID)
+		}
+	}
+
+	if signingKey, err := keys.signingKey(); err != nil || !signingKey.Equal(newKey) {
+		t.Errorf("signing key = %v, %v, want the key 2024-01-01", signingKey, err)
+	}
+}
+
+func TestLoadKeySetFile(t *testing.T) {
+	dir := t.TempDir()
+	key := writeKey(t, dir, "jwt")
+
+	keys, err := LoadKeySet(filepath.Join(dir, "jwt"+keyFileExt))
+	if err != nil {
+		t.Fatalf("loading key set: %v", err)
+	}
+
+	if keys.SigningKeyID != "jwt" || len(keys.Keys) != 1 || !keys.Keys["jwt"].Equal(key) {
+		t.Errorf("key set = %+v, want the single key jwt", keys)
+	}
+}
+
+func TestLoadKeySetErrors(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("generating RSA key: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		setup func(dir string) string
+	}{
+		{"missing path", func(dir string) string {
+			return filepath.Join(dir, "missing")
+		}},
+		{"empty directory", func(dir string) string {
+			return dir
+		}},
+		{"directory without keys", func(dir string) string {
+			writeFile(t, filepath.Join(dir, "key.txt"), []byte("not a key"))
+			return dir
+		}},
+		{"not PEM", func(dir string) string {
+			writeKey(t, dir, "valid")
+			writeFile(t, filepath.Join(dir, "invalid.pem"), []byte("not a key"))
+			return dir
+		}},
+		{"not ECDSA", func(dir string) string {
+			path := filepath.Join(dir, "rsa.pem")
+			writeFile(t, path, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}))
+			return path
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := LoadKeySet(tt.setup(t.TempDir())); err == nil {
+				t.Error("key set loaded without error")
+			}
+		})
+	}
+}
+
+func TestKeyRotation(t *testing.T) {
+	dir := t.TempDir()
+	writeKey(t, dir, "2023-01-01")
+
+	oldKeys, err := LoadKeySet(dir)
+	if err != nil {
+		t.Fatalf("loading key set: %v", err)
+	}
+
+	authorizer, err := NewAuthorizer(oldKeys)
+	if err != nil {
+		t.Fatalf("creating authorizer: %v", err)
+	}
+
+	claims := jwt.Claims{Subject: "1", Expiry: jwt.NewNumericDate(time.Now().Add(time.Hour))}
+	oldToken, err := authorizer.Construct(claims)
+	if err != nil {
+		t.Fatalf("constructing token: %v", err)
+	}
+
+	// Rotate the keys by adding a new signing key while keeping the previous one for validation
+	writeKey(t, dir, "2024-01-01")
+	rotatedKeys, err := LoadKeySet(dir)
+	if err != nil {
+		t.Fatalf("loading rotated key set: %v", err)
+	}
+
+	if err := authorizer.SetKeys(rotatedKeys); err != nil {
+		t.Fatalf("setting rotated keys: %v", err)
+	}
+
+	newToken, err := authorizer.Construct(claims)
+	if err != nil {
+		t.Fatalf("constructing token: %v", err)
+	}
+
+	for token, keyID := range map[string]string{oldToken: "2023-01-01", newToken: "2024-01-01"} {
+		if got := tokenKeyID(t, token); got != keyID {
+			t.Errorf("token key ID = %q, want %q", got, keyID)
+		}
+
+		var parsed jwt.Claims
+		if !authorizer.VerifyAndParse(token, &parsed) || parsed.Subject != claims.Subject {
+			t.Errorf("token signed with %s not valid after rotation", keyID)
+		}
+	}
+
+	// Tokens signed with a key removed from the set are no longer valid
+	if err := os.Remove(filepath.Join(dir, "2023-01-01"+keyFileExt)); err != nil {
+		t.Fatalf("removing old key: %v", err)
+	}
+
+	currentKeys, err := LoadKeySet(dir)
+	if err != nil {
+		t.Fatalf("loading key set: %v", err)
+	}
+
+	if err := authorizer.SetKeys(currentKeys); err != nil {
+		t.Fatalf("setting keys: %v", err)
+	}
+
+	var parsed jwt.Claims
+	if authorizer.VerifyAndParse(oldToken, &parsed) {
+		t.Error("token signed with a removed key is valid")
+	} else if !authorizer.VerifyAndParse(newToken, &parsed) {
+		t.Error("token signed with the current key is not valid")
+	}
+}
+
+// Tokens constructed before key IDs were introduced are validated using all of the keys.
+func TestTokenWithoutKeyID(t *testing.T) {
+	dir := t.TempDir()
+	legacyKey := writeKey(t, dir, "2023-01-01")
+	writeKey(t, dir, "2024-01-01")
+
+	keys, err := LoadKeySet(dir)
+	if err != nil {
+		t.Fatalf("loading key set: %v", err)
+	}
+
+	authorizer, err := NewAuthorizer(keys)
+	if err != nil {
+		t.Fatalf("creating authorizer: %v", err)
+	}
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: legacyKey}, nil)
+	if err != nil {
+		t.Fatalf("creating signer: %v", err)
+	}
+
+	encrypter, err := jose.NewEncrypter(jose.A256GCM,
+		jose.Recipient{Algorithm: jose.ECDH_ES_A256KW, Key: &legacyKey.PublicKey},
+		(&jose.EncrypterOptions{}).WithContentType(encrypterType).WithType(encrypterType))
+	if err != nil {
+		t.Fatalf("creating encrypter: %v", err)
+	}
+
+	claims := jwt.Claims{Subject: "1", Expiry: jwt.NewNumericDate(time.Now().Add(time.Hour))}
+	token, err := jwt.SignedAndEncrypted(signer, encrypter).Claims(claims).CompactSerialize()
+	if err != nil {
+		t.Fatalf("constructing token: %v", err)
+	}
+
+	var parsed jwt.Claims
+	if !authorizer.VerifyAndParse(token, &parsed) || parsed.Subject != claims.Subject {
+		t.Error("token without key ID not valid")
+	}
+}
+
+func tokenKeyID(t *testing.T, token string) string {
+	t.Helper()
+
+	parsed, err := jwt.ParseSignedAndEncrypted(token)
+	if err != nil {
+		t.Fatalf("parsing token: %v", err)
+	}
+
+	return parsed.Headers[0].KeyID
+}
@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// Permission is a single permission which can be granted to the holder of a token.
+type Permission string
+
+// Policy is the access rule of a single gRPC method.
+type Policy struct {
+	// Public methods can be called without a token
+	Public bool
+	// Account types which are allowed to call a non-public method, any type is allowed if empty
+	AccountTypes []string
+	// Permissions which must all be granted to the caller of a non-public method
+	Permissions []Permission
+}
+
+// Principal is implemented by claims which are granted permissions.
+type Principal interface {
+	HasAccountType(accountType string) bool
+	HasPermission(permission Permission) bool
+}
+
+// PolicyReader reads the policy of a method from its descriptor, returning false if the method isn't annotated.
+type PolicyReader func(method protoreflect.MethodDescriptor) (Policy, bool, error)
+
+// LoadPolicies reads the access policies of all of the methods of the registered service with the given name.
+// An error is returned if the service isn't registered or if any of its methods don't have a policy,
+// so that newly added or renamed methods can't be left unprotected by accident.
+func LoadPolicies(serviceName string, read PolicyReader) (map[string]Policy, error) {
+	descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(serviceName))
+	if err != nil {
+		return nil, fmt.Errorf("finding service %s descriptor: %w", serviceName, err)
+	}
+
+	service, ok := descriptor.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("descriptor %s is not a service", serviceName)
+	}
+
+	methods := service.Methods()
+	policies := make(map[string]Policy, methods.Len())
+	for i := 0; i < methods.Len(); i++ {
+		method := methods.Get(i)
+		fullMethod := fmt.Sprintf("/%s/%s", service.FullName(), method.Name())
+
+		policy, ok, err := read(method)
+		if err != nil {
+			return nil, fmt.Errorf("reading method %s access policy: %w", fullMethod, err)
+		} else if !ok {
+			return nil, fmt.Errorf("method %s has no access policy", fullMethod)
+		}
+
+		policies[fullMethod] = policy
+	}
+
+	return policies, nil
+}
@@ -0,0 +1,149 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+var ErrNotFound = errors.New("blob not found")
+
+// Store keeps the contents of the files uploaded by the users under unique keys.
+type Store interface {
+	// Put stores the contents read from the reader under the key, replacing any existing blob.
+	Put(ctx context.Context, key string, r io.Reader) error
+	// Get opens the blob stored under the key, returning ErrNotFound if it doesn't exist.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete deletes the blob stored under the key, if it exists.
+	Delete(ctx context.Context, key string) error
+	// List returns the keys of the blobs which have been stored before the time.
+	List(ctx context.Context, storedBefore time.Time) ([]string, error)
+}
+
+// NewStore creates a store of the specified kind. The only supported kind is "fs",
+// which stores the blobs as files in the specified directory.
+func NewStore(kind string, dir string) (Store, error) {
+	switch kind {
+	case "fs":
+		return NewFSStore(dir)
+	default:
+		return nil, fmt.Errorf("unknown blob store %q", kind)
+	}
+}
+
+// FSStore is a store which keeps each blob in a separate file in a directory on the local filesystem.
+type FSStore struct {
+	dir string
+}
+
+// NewFSStore creates a new store which keeps the blobs in the directory, creating it if needed.
+func NewFSStore(dir string) (*FSStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("creating blob directory %s: %w", dir, err)
+	}
+
+	return &FSStore{dir: dir}, nil
+}
+
+// Put writes the contents to a temporary file first, so that partially written blobs are never visible.
+func (s *FSStore) Put(_ context.Context, key string, r io.Reader) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(s.dir, ".upload-*")
+	if err != nil {
+		return fmt.Errorf("creating temporary blob file: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return fmt.Errorf("writing blob %s: %w", key, err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("closing blob %s: %w", key, err)
+	}
+
+	if err := os.Rename(f.Name(), path); err != nil {
+		return fmt.Errorf("moving blob %s into place: %w", key, err)
+	}
+
+	return nil
+}
+
+// Get opens the file of the blob.
+func (s *FSStore) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("opening blob %s: %w", key, err)
+	}
+
+	return f, nil
+}
+
+// Delete removes the file of the blob.
+func (s *FSStore) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("deleting blob %s: %w", key, err)
+	}
+
+	return nil
+}
+
+// List reads the directory, skipping the temporary files of the blobs which are still being written.
+func (s *FSStore) List(_ context.Context, storedBefore time.Time) ([]string, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading blob directory: %w", err)
+	}
+
+	var keys []string
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		info, err := entry.Info()
+		if errors.Is(err, os.ErrNotExist) {
+			// Deleted concurrently
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("getting blob %s info: %w", entry.Name(), err)
+		}
+
+		if info.ModTime().Before(storedBefore) {
+			keys = append(keys, entry.Name())
+		}
+	}
+
+	return keys, nil
+}
+
+// path returns the path of the blob's file, rejecting keys which could point outside of the directory.
+func (s *FSStore) path(key string) (string, error) {
+	if key == "" || strings.HasPrefix(key, ".") || strings.ContainsAny(key, `/\`) {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+
+	return filepath.Join(s.dir, key), nil
+}
@@ -0,0 +1,48 @@
+package blob
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFSStoreList(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	store, err := NewFSStore(dir)
+	if err != nil {
+		t.Fatalf("creating store: %v", err)
+	}
+
+	for _, key := range []string{"old", "new"} {
+		if err := store.Put(ctx, key, strings.NewReader(key)); err != nil {
+			t.Fatalf("putting blob %s: %v", key, err)
+		}
+	}
+
+	storedAt := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(filepath.Join(dir, "old"), storedAt, storedAt); err != nil {
+		t.Fatalf("changing blob time: %v", err)
+	}
+
+	// Blobs which are still being written must never be listed
+	if err := os.WriteFile(filepath.Join(dir, ".upload-1"), nil, 0o600); err != nil {
+		t.Fatalf("creating temporary file: %v", err)
+	}
+	if err := os.Chtimes(filepath.Join(dir, ".upload-1"), storedAt, storedAt); err != nil {
+		t.Fatalf("changing temporary file time: %v", err)
+	}
+
+	keys, err := store.List(ctx, time.Now().Add(-time.Minute))
+	if err != nil {
+		t.Fatalf("listing blobs: %v", err)
+	}
+
+	if len(keys) != 1 || keys[0] != "old" {
+		t.Errorf("keys = %v, want [old]", keys)
+	}
+}
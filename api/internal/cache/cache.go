@@ -0,0 +1,134 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Cache is a concurrency-safe in-memory cache holding up to a fixed number of entries for a limited time.
+// When the cache is full, the least recently used entries are evicted to make room for new ones.
+type Cache[K comparable, V any] struct {
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+	entries map[K]*list.Element
+	// Front of the list contains the most recently used entries
+	recency *list.List
+	// Incremented whenever entries are deleted or cleared, see SetIfVersion
+	version uint64
+}
+
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time
+}
+
+// New creates a new cache holding up to size entries for the TTL.
+// A cache with a non-positive size or TTL is disabled and never returns any entries.
+func New[K comparable, V any](size int, ttl time.Duration) *Cache[K, V] {
+	return &Cache[K, V]{
+		size:    size,
+		ttl:     ttl,
+		entries: make(map[K]*list.Element),
+		recency: list.New(),
+	}
+}
+
+// Get returns the value stored for the key if it exists and hasn't expired yet.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero V
+	elem, ok := c.entries[key]
+	if !ok {
+		return zero, false
+	}
+
+	e := elem.Value.(*entry[K, V])
+	if time.Now().After(e.expiresAt) {
+		c.remove(elem)
+		return zero, false
+	}
+
+	c.recency.MoveToFront(elem)
+	return e.value, true
+}
+
+// Set stores the value for the key, replacing the existing one and resetting its expiry.
+func (c *Cache[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.set(key, value)
+}
+
+// Version returns the current version of the cache, which changes whenever any entries are deleted or cleared.
+func (c *Cache[K, V]) Version() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.version
+}
+
+// SetIfVersion stores the value like Set, unless any entries have been deleted or cleared since Version returned
+// the version. Values loaded before the version is taken thus can't overwrite the invalidations made while loading them.
+func (c *Cache[K, V]) SetIfVersion(key K, value V, version uint64) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.version != version {
+		return false
+	}
+
+	c.set(key, value)
+	return true
+}
+
+func (c *Cache[K, V]) set(key K, value V) {
+	if c.size <= 0 || c.ttl <= 0 {
+		return
+	}
+
+	expiresAt := time.Now().Add(c.ttl)
+	if elem, ok := c.entries[key]; ok {
+		e := elem.Value.(*entry[K, V])
+		e.value, e.expiresAt = value, expiresAt
+		c.recency.MoveToFront(elem)
+		return
+	}
+
+	for c.recency.Len() >= c.size {
+		c.remove(c.recency.Back())
+	}
+
+	c.entries[key] = c.recency.PushFront(&entry[K, V]{key: key, value: value, expiresAt: expiresAt})
+}
+
+// Delete removes the value stored for the key, if any.
+func (c *Cache[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.version++
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+}
+
+// Clear removes all of the stored values.
+func (c *Cache[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.version++
+	c.entries = make(map[K]*list.Element)
+	c.recency.Init()
+}
+
+func (c *Cache[K, V]) remove(elem *list.Element) {
+	c.recency.Remove(elem)
+	delete(c.entries, elem.Value.(*entry[K, V]).key)
+}
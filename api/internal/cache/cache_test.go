@@ -0,0 +1,181 @@
+package cache
+
+import (
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const benchmarkKeys = 1 << 12
+
+func TestEvictsLeastRecentlyUsed(t *testing.T) {
+	c := New[string, int](2, time.Minute)
+	c.Set("a", 1)
+	c.Set("b", 2)
+
+	// Reading a makes b the least recently used entry
+	if _, ok := c.Get("a"); !ok {
+		t.Fatal("a not found")
+	}
+
+	c.Set("c", 3)
+	if _, ok := c.Get("b"); ok {
+		t.Error("b wasn't evicted")
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, ok := c.Get(key); !ok {
+			t.Errorf("%s was evicted", key)
+		}
+	}
+
+	// Updating an entry makes it the most recently used one without evicting anything
+	c.Set("a", 10)
+	c.Set("d", 4)
+	if value, ok := c.Get("a"); !ok || value != 10 {
+		t.Errorf("a = %d, %v, want 10, true", value, ok)
+	}
+	if _, ok := c.Get("c"); ok {
+		t.Error("c wasn't evicted")
+	}
+}
+
+func TestExpiresEntries(t *testing.T) {
+	c := New[string, int](10, time.Millisecond*20)
+	c.Set("a", 1)
+
+	if _, ok := c.Get("a"); !ok {
+		t.Fatal("a expired too early")
+	}
+
+	time.Sleep(time.Millisecond * 30)
+	if _, ok := c.Get("a"); ok {
+		t.Error("a didn't expire")
+	}
+
+	// Setting the entry again resets the expiry
+	c.Set("a", 2)
+	if value, ok := c.Get("a"); !ok || value != 2 {
+		t.Errorf("a = %d, %v, want 2, true", value, ok)
+	}
+}
+
+func TestInvalidation(t *testing.T) {
+	c := New[string, int](10, time.Minute)
+	c.Set("a", 1)
+	c.Set("b", 2)
+
+	c.Delete("a")
+	if _, ok := c.Get("a"); ok {
+		t.Error("a found after deleting")
+	}
+	if _, ok := c.Get("b"); !ok {
+		t.Error("b deleted along with a")
+	}
+
+	c.Clear()
+	if _, ok := c.Get("b"); ok {
+		t.Error("b found after clearing")
+	}
+}
+
+// A value loaded before an invalidation must not be stored after it, or the invalidation would be lost.
+func TestSetIfVersion(t *testing.T) {
+	c := New[string, int](10, time.Minute)
+
+	version := c.Version()
+	c.Delete("a")
+	if c.SetIfVersion("a", 1, version) {
+		t.Error("value stored after a deletion")
+	}
+	if _, ok := c.Get("a"); ok {
+		t.Error("stale value found")
+	}
+
+	version = c.Version()
+	c.Clear()
+	if c.SetIfVersion("a", 1, version) {
+		t.Error("value stored after clearing")
+	}
+
+	version = c.Version()
+	c.Set("b", 2)
+	if !c.SetIfVersion("a", 1, version) {
+		t.Error("value not stored without invalidations")
+	}
+	if value, ok := c.Get("a"); !ok || value != 1 {
+		t.Errorf("a = %d, %v, want 1, true", value, ok)
+	}
+}
+
+func TestDisabled(t *testing.T) {
+	for _, c := range []*Cache[string, int]{New[string, int](0, time.Minute), New[string, int](10, 0)} {
+		c.Set("a", 1)
+		if _, ok := c.Get("a"); ok {
+			t.Error("disabled cache returned a value")
+		}
+	}
+}
+
+func benchmarkSessionIDs() []string {
+	ids := make([]string, benchmarkKeys)
+	for i := range ids {
+		ids[i] = "session-" + strconv.Itoa(i)
+	}
+	return ids
+}
+
+// BenchmarkGetParallel measures the lookups of cached sessions by concurrent requests, which is the common case.
+func BenchmarkGetParallel(b *testing.B) {
+	ids := benchmarkSessionIDs()
+	c := New[string, int](benchmarkKeys, time.Minute)
+	for i, id := range ids {
+		c.Set(id, i)
+	}
+
+	var counter atomic.Uint64
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, ok := c.Get(ids[counter.Add(1)%benchmarkKeys]); !ok {
+				b.Fatal("cached session not found")
+			}
+		}
+	})
+}
+
+// BenchmarkGetSetParallel measures the lookups under contention with updates,
+// with one in ten requests missing the cache and storing the loaded session.
+func BenchmarkGetSetParallel(b *testing.B) {
+	ids := benchmarkSessionIDs()
+	c := New[string, int](benchmarkKeys/2, time.Minute)
+
+	var counter atomic.Uint64
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			n := counter.Add(1)
+			id := ids[n%benchmarkKeys]
+			if _, ok := c.Get(id); !ok || n%10 == 0 {
+				c.Set(id, int(n))
+			}
+		}
+	})
+}
+
+// BenchmarkDisabled measures the overhead left on each request when caching is disabled.
+func BenchmarkDisabled(b *testing.B) {
+	ids := benchmarkSessionIDs()
+	c := New[string, int](0, 0)
+
+	var counter atomic.Uint64
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			id := ids[counter.Add(1)%benchmarkKeys]
+			if _, ok := c.Get(id); !ok {
+				c.Set(id, 0)
+			}
+		}
+	})
+}
@@ -1,22 +1,45 @@
 package crypto
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
 	"fmt"
-
-	"golang.org/x/crypto/bcrypt"
+	"math/big"
 )
 
-// HashPassword hashes a password using bcrypt
-func HashPassword(password string) ([]byte, error) {
-	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
-	if err != nil {
-		return nil, fmt.Errorf("generating hash using bcrypt: %w", err)
+// tokenLength is the number of random bytes used for generated tokens
+const tokenLength = 32
+
+// GenerateToken generates a new random URL-safe token which can be handed out to clients.
+func GenerateToken() (string, error) {
+	b := make([]byte, tokenLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("reading random bytes: %w", err)
 	}
 
-	return hashed, nil
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// HashToken hashes a token generated using GenerateToken for storage.
+// Unlike passwords, such tokens have enough entropy to not require a slow hash.
+func HashToken(token string) []byte {
+	hash := sha256.Sum256([]byte(token))
+	return hash[:]
 }
 
-// ValidateHashedPassword validates the given password using its hash.
-func ValidateHashedPassword(password string, hash []byte) bool {
-	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+// GenerateCode generates a new random numeric code with the specified number of digits,
+// meant to be entered by users manually. Such codes must be protected against brute-forcing.
+func GenerateCode(digits int) (string, error) {
+	code := make([]byte, digits)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", fmt.Errorf("generating random digit: %w", err)
+		}
+
+		code[i] = byte('0' + n.Int64())
+	}
+
+	return string(code), nil
 }
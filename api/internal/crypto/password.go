@@ -0,0 +1,126 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Argon2Params are the parameters of the argon2id password hash, which are stored along with the hash itself
+// in the PHC string format, so that they can be changed without invalidating the existing hashes.
+type Argon2Params struct {
+	// Memory in KiB
+	Memory     uint32
+	Iterations uint32
+	Threads    uint8
+	SaltLength uint32
+	KeyLength  uint32
+}
+
+// DefaultPasswordParams are the second recommended option from RFC 9106.
+var DefaultPasswordParams = Argon2Params{
+	Memory:     64 * 1024,
+	Iterations: 3,
+	Threads:    4,
+	SaltLength: 16,
+	KeyLength:  32,
+}
+
+// currentPasswordParams are used for all new password hashes. Hashes with other parameters,
+// as well as the older bcrypt hashes, are reported by PasswordNeedsRehash.
+var currentPasswordParams = DefaultPasswordParams
+
+// SetPasswordParams changes the parameters used for the new password hashes. It must be called
+// before any passwords are hashed, since the parameters aren't guarded against concurrent access.
+func SetPasswordParams(params Argon2Params) error {
+	if params.Memory < 8*uint32(params.Threads) || params.Iterations < 1 || params.Threads < 1 ||
+		params.SaltLength < 8 || params.KeyLength < 16 {
+		return fmt.Errorf("invalid argon2id parameters %+v", params)
+	}
+
+	currentPasswordParams = params
+	return nil
+}
+
+const argon2idPrefix = "$argon2id$"
+
+var base64NoPadding = base64.RawStdEncoding
+
+// HashPassword hashes a password using argon2id with the current parameters.
+func HashPassword(password string) ([]byte, error) {
+	params := currentPasswordParams
+
+	salt := make([]byte, params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("reading random salt: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Threads, params.KeyLength)
+
+	return []byte(fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s", argon2idPrefix, argon2.Version,
+		params.Memory, params.Iterations, params.Threads,
+		base64NoPadding.EncodeToString(salt), base64NoPadding.EncodeToString(key),
+	)), nil
+}
+
+// ValidateHashedPassword validates the given password using its hash,
+// which can either be an argon2id hash or a legacy bcrypt one.
+func ValidateHashedPassword(password string, hash []byte) bool {
+	if !bytes.HasPrefix(hash, []byte(argon2idPrefix)) {
+		return bcrypt.CompareHashAndPassword(hash, []byte(password)) == nil
+	}
+
+	params, salt, key, err := decodeArgon2Hash(hash)
+	if err != nil {
+		return false
+	}
+
+	other := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Threads, params.KeyLength)
+	return subtle.ConstantTimeCompare(key, other) == 1
+}
+
+// PasswordNeedsRehash checks if the hash has been created using an outdated algorithm or parameters,
+// meaning that the password should be hashed again the next time it is available in plaintext.
+func PasswordNeedsRehash(hash []byte) bool {
+	if !bytes.HasPrefix(hash, []byte(argon2idPrefix)) {
+		return true
+	}
+
+	params, _, _, err := decodeArgon2Hash(hash)
+	return err != nil || params != currentPasswordParams
+}
+
+func decodeArgon2Hash(hash []byte) (Argon2Params, []byte, []byte, error) {
+	var version int
+	var params Argon2Params
+	var encodedSalt, encodedKey string
+
+	// Replace the separators with spaces so that fmt can scan the salt and key
+	fields := bytes.ReplaceAll(bytes.TrimPrefix(hash, []byte(argon2idPrefix)), []byte("$"), []byte(" "))
+	_, err := fmt.Sscanf(string(fields), "v=%d m=%d,t=%d,p=%d %s %s",
+		&version, &params.Memory, &params.Iterations, &params.Threads, &encodedSalt, &encodedKey)
+	if err != nil {
+		return Argon2Params{}, nil, nil, fmt.Errorf("scanning argon2id hash: %w", err)
+	} else if version != argon2.Version {
+		return Argon2Params{}, nil, nil, fmt.Errorf("unsupported argon2 version %d", version)
+	}
+
+	salt, err := base64NoPadding.DecodeString(encodedSalt)
+	if err != nil {
+		return Argon2Params{}, nil, nil, fmt.Errorf("decoding salt: %w", err)
+	}
+
+	key, err := base64NoPadding.DecodeString(encodedKey)
+	if err != nil {
+		return Argon2Params{}, nil, nil, fmt.Errorf("decoding key: %w", err)
+	}
+
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+	return params, salt, key, nil
+}
@@ -0,0 +1,216 @@
+package crypto
+
+import (
+	"bytes"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Cheap parameters which keep the tests fast, the hashing code doesn't depend on their values.
+var testPasswordParams = Argon2Params{Memory: 64, Iterations: 1, Threads: 1, SaltLength: 16, KeyLength: 32}
+
+func setTestPasswordParams(t *testing.T, params Argon2Params) {
+	t.Helper()
+
+	previous := currentPasswordParams
+	if err := SetPasswordParams(params); err != nil {
+		t.Fatalf("setting password params: %v", err)
+	}
+	t.Cleanup(func() { currentPasswordParams = previous })
+}
+
+func TestHashPasswordRoundTrip(t *testing.T) {
+	setTestPasswordParams(t, testPasswordParams)
+
+	tests := []struct {
+		name     string
+		password string
+	}{
+		{"ascii", "correct horse battery staple"},
+		{"unicode", "Пароль-123"},
+		{"empty", ""},
+		{"separators", "$argon2id$v=19$m=1,t=1,p=1$"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash, err := HashPassword(tt.password)
+			if err != nil {
+				t.Fatalf("hashing password: %v", err)
+			}
+
+			if !bytes.HasPrefix(hash, []byte("$argon2id$v=19$m=64,t=1,p=1$")) {
+				t.Errorf("hash = %s, want the PHC format with the current params", hash)
+			}
+
+			params, salt, key, err := decodeArgon2Hash(hash)
+			if err != nil {
+				t.Fatalf("decoding hash: %v", err)
+			} else if params != testPasswordParams {
+				t.Errorf("decoded params = %+v, want %+v", params, testPasswordParams)
+			} else if len(salt) != int(testPasswordParams.SaltLength) || len(key) != int(testPasswordParams.KeyLength) {
+				t.Errorf("decoded salt and key lengths = %d, %d", len(salt), len(key))
+			}
+
+			if !ValidateHashedPassword(tt.password, hash) {
+				t.Error("password doesn't match its own hash")
+			} else if ValidateHashedPassword(tt.password+"x", hash) {
+				t.Error("another password matches the hash")
+			}
+
+			if PasswordNeedsRehash(hash) {
+				t.Error("fresh hash needs rehash")
+			}
+		})
+	}
+}
+
+func TestHashPasswordUsesRandomSalt(t *testing.T) {
+	setTestPasswordParams(t, testPasswordParams)
+
+	first, err := HashPassword("password")
+	if err != nil {
+		t.Fatalf("hashing password: %v", err)
+	}
+
+	second, err := HashPassword("password")
+	if err != nil {
+		t.Fatalf("hashing password: %v", err)
+	}
+
+	if bytes.Equal(first, second) {
+		t.Error("hashes of the same password are equal")
+	}
+}
+
+func TestValidateBcryptPassword(t *testing.T) {
+	setTestPasswordParams(t, testPasswordParams)
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("legacy-password"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("hashing with bcrypt: %v", err)
+	}
+
+	tests := []struct {
+		password string
+		want     bool
+	}{
+		{"legacy-password", true},
+		{"another-password", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := ValidateHashedPassword(tt.password, hash); got != tt.want {
+			t.Errorf("ValidateHashedPassword(%q) = %v, want %v", tt.password, got, tt.want)
+		}
+	}
+
+	if !PasswordNeedsRehash(hash) {
+		t.Error("bcrypt hash doesn't need rehash")
+	}
+}
+
+func TestPasswordNeedsRehash(t *testing.T) {
+	setTestPasswordParams(t, testPasswordParams)
+
+	hash, err := HashPassword("password")
+	if err != nil {
+		t.Fatalf("hashing password: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		params Argon2Params
+		want   bool
+	}{
+		{"same params", testPasswordParams, false},
+		{"more memory", Argon2Params{Memory: 128, Iterations: 1, Threads: 1, SaltLength: 16, KeyLength: 32}, true},
+		{"more iterations", Argon2Params{Memory: 64, Iterations: 2, Threads: 1, SaltLength: 16, KeyLength: 32}, true},
+		{"more threads", Argon2Params{Memory: 64, Iterations: 1, Threads: 2, SaltLength: 16, KeyLength: 32}, true},
+		{"longer salt", Argon2Params{Memory: 64, Iterations: 1, Threads: 1, SaltLength: 32, KeyLength: 32}, true},
+		{"longer key", Argon2Params{Memory: 64, Iterations: 1, Threads: 1, SaltLength: 16, KeyLength: 64}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setTestPasswordParams(t, tt.params)
+
+			if got := PasswordNeedsRehash(hash); got != tt.want {
+				t.Errorf("PasswordNeedsRehash = %v, want %v", got, tt.want)
+			}
+
+			// Hashes with outdated params must still be accepted until they are replaced
+			if !ValidateHashedPassword("password", hash) {
+				t.Error("password doesn't match its hash after the params changed")
+			}
+		})
+	}
+}
+
+func TestMalformedArgon2Hashes(t *testing.T) {
+	setTestPasswordParams(t, testPasswordParams)
+
+	tests := []struct {
+		name string
+		hash string
+	}{
+		{"empty", "$argon2id$"},
+		{"unsupported version", "$argon2id$v=16$m=64,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2U"},
+		{"missing params", "$argon2id$v=19$c2FsdHNhbHRzYWx0c2FsdA$a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2U"},
+		{"missing key", "$argon2id$v=19$m=64,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA"},
+		{"invalid salt", "$argon2id$v=19$m=64,t=1,p=1$!!!$a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2U"},
+		{"invalid key", "$argon2id$v=19$m=64,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$!!!"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, _, err := decodeArgon2Hash([]byte(tt.hash)); err == nil {
+				t.Error("malformed hash decoded without error")
+			}
+
+			if ValidateHashedPassword("password", []byte(tt.hash)) {
+				t.Error("malformed hash matches a password")
+			}
+
+			if !PasswordNeedsRehash([]byte(tt.hash)) {
+				t.Error("malformed hash doesn't need rehash")
+			}
+		})
+	}
+}
+
+func TestSetPasswordParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		params Argon2Params
+		valid  bool
+	}{
+		{"default", DefaultPasswordParams, true},
+		{"minimal", Argon2Params{Memory: 8, Iterations: 1, Threads: 1, SaltLength: 8, KeyLength: 16}, true},
+		{"too little memory for threads", Argon2Params{Memory: 8, Iterations: 1, Threads: 2, SaltLength: 16, KeyLength: 32}, false},
+		{"no iterations", Argon2Params{Memory: 64, Iterations: 0, Threads: 1, SaltLength: 16, KeyLength: 32}, false},
+		{"no threads", Argon2Params{Memory: 64, Iterations: 1, Threads: 0, SaltLength: 16, KeyLength: 32}, false},
+		{"short salt", Argon2Params{Memory: 64, Iterations: 1, Threads: 1, SaltLength: 4, KeyLength: 32}, false},
+		{"short key", Argon2Params{Memory: 64, Iterations: 1, Threads: 1, SaltLength: 16, KeyLength: 8}, false},
+	}
+
+	previous := currentPasswordParams
+	t.Cleanup(func() { currentPasswordParams = previous })
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			currentPasswordParams = previous
+
+			err := SetPasswordParams(tt.params)
+			if tt.valid && err != nil {
+				t.Errorf("error = %v, want none", err)
+			} else if !tt.valid && err == nil {
+				t.Error("invalid params accepted")
+			} else if !tt.valid && currentPasswordParams != previous {
+				t.Error("invalid params replaced the current ones")
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package crypto
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// Length of generated TOTP secrets, as recommended by RFC 4226 for HMAC-SHA1
+	totpSecretLength = 20
+	totpDigits       = 6
+	totpPeriod       = 30 * time.Second
+	// Number of periods before and after the current one in which codes are still accepted to allow for clock drift
+	totpSkew = 1
+
+	// Recovery codes consist of two groups of characters separated with a dash
+	recoveryCodeGroupLength = 5
+)
+
+var base32NoPadding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTPSecret generates a new random secret for TOTP two-factor authentication.
+func GenerateTOTPSecret() ([]byte, error) {
+	secret := make([]byte, totpSecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("reading random bytes: %w", err)
+	}
+
+	return secret, nil
+}
+
+// EncodeTOTPSecret encodes a TOTP secret in base32 as expected by authenticator apps.
+func EncodeTOTPSecret(secret []byte) string {
+	return base32NoPadding.EncodeToString(secret)
+}
+
+// TOTPProvisioningURI constructs the otpauth:// URI used to add the secret to authenticator apps via QR codes.
+func TOTPProvisioningURI(issuer, accountName string, secret []byte) string {
+	params := url.Values{}
+	params.Set("secret", EncodeTOTPSecret(secret))
+	params.Set("issuer", issuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprint(totpDigits))
+	params.Set("period", fmt.Sprint(int(totpPeriod.Seconds())))
+
+	return (&url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + accountName,
+		RawQuery: params.Encode(),
+	}).String()
+}
+
+// ValidateTOTP validates the code generated by an authenticator app at the specified time
+// and returns the time step which the code corresponds to. The step must be stored
+// and later codes with the same or earlier steps rejected in order to prevent replays.
+func ValidateTOTP(secret []byte, code string, t time.Time) (int64, bool) {
+	if len(code) != totpDigits {
+		return 0, false
+	}
+
+	current := t.Unix() / int64(totpPeriod.Seconds())
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if subtle.ConstantTimeCompare([]byte(totpCode(secret, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+// totpCode generates the code for the time step as described in RFC 6238.
+func totpCode(secret []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", totpDigits, value%mod)
+}
+
+// GenerateRecoveryCode generates a new random one-time recovery code for two-factor authentication.
+// The codes should be normalized using NormalizeRecoveryCode and hashed using HashToken before storage.
+func GenerateRecoveryCode() (string, error) {
+	b := make([]byte, recoveryCodeGroupLength*2*5/8)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("reading random bytes: %w", err)
+	}
+
+	code := strings.ToLower(base32NoPadding.EncodeToString(b))
+	return code[:recoveryCodeGroupLength] + "-" + code[recoveryCodeGroupLength:], nil
+}
+
+// NormalizeRecoveryCode normalizes a recovery code entered by the user so that
+// the differences in case and separators don't matter.
+func NormalizeRecoveryCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+
+		return r
+	}, strings.ToLower(code))
+}
@@ -0,0 +1,95 @@
+package crypto
+
+import (
+	"testing"
+	"time"
+)
+
+// Secret of the RFC 6238 test vectors for HMAC-SHA1
+var rfc6238Secret = []byte("12345678901234567890")
+
+// The RFC 6238 appendix B vectors for SHA1, truncated to the six digits used by authenticator apps.
+func TestTOTPCodeRFC6238(t *testing.T) {
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+
+	for _, tt := range tests {
+		if code := totpCode(rfc6238Secret, tt.unix/30); code != tt.code {
+			t.Errorf("code at %d = %s, want %s", tt.unix, code, tt.code)
+		}
+
+		step, ok := ValidateTOTP(rfc6238Secret, tt.code, time.Unix(tt.unix, 0))
+		if !ok || step != tt.unix/30 {
+			t.Errorf("ValidateTOTP at %d = %d, %v, want %d, true", tt.unix, step, ok, tt.unix/30)
+		}
+	}
+}
+
+func TestValidateTOTPSkew(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	current := now.Unix() / 30
+
+	tests := []struct {
+		name   string
+		offset int64
+		valid  bool
+	}{
+		{"previous step", -1, true},
+		{"current step", 0, true},
+		{"next step", 1, true},
+		{"two steps ago", -2, false},
+		{"two steps ahead", 2, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, ok := ValidateTOTP(rfc6238Secret, totpCode(rfc6238Secret, current+tt.offset), now)
+			if ok != tt.valid {
+				t.Fatalf("valid = %v, want %v", ok, tt.valid)
+			} else if ok && step != current+tt.offset {
+				t.Errorf("step = %d, want %d", step, current+tt.offset)
+			}
+		})
+	}
+}
+
+func TestValidateTOTPRejectsMalformedCodes(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	code := totpCode(rfc6238Secret, now.Unix()/30)
+
+	for _, malformed := range []string{"", code[:5], code + "0", "abcdef", " " + code[1:]} {
+		if _, ok := ValidateTOTP(rfc6238Secret, malformed, now); ok {
+			t.Errorf("code %q accepted", malformed)
+		}
+	}
+
+	if _, ok := ValidateTOTP([]byte("another secret12345"), code, now); ok {
+		t.Error("code accepted with another secret")
+	}
+}
+
+// A reused code validates to the same step again, which is what lets the caller reject it
+// by only accepting steps later than the last used one, see storage.UseTOTPStep.
+func TestValidateTOTPReusedCodeHasSameStep(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	code := totpCode(rfc6238Secret, now.Unix()/30)
+
+	first, ok := ValidateTOTP(rfc6238Secret, code, now)
+	if !ok {
+		t.Fatal("code rejected")
+	}
+
+	second, ok := ValidateTOTP(rfc6238Secret, code, now.Add(time.Second*30))
+	if !ok || second != first {
+		t.Errorf("reused code step = %d, %v, want %d, true", second, ok, first)
+	}
+}
@@ -0,0 +1,89 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/exp/slog"
+)
+
+// Message is a single email message to be sent to a user.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Sender delivers email messages to users.
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// NewSender creates a sender of the specified kind. Supported kinds are "log", which logs all of the messages
+// using the logger, and "file", which stores the messages as files in the specified directory.
+func NewSender(kind string, logger *slog.Logger, dir string) (Sender, error) {
+	switch kind {
+	case "log":
+		return NewLogSender(logger), nil
+	case "file":
+		return NewFileSender(dir)
+	default:
+		return nil, fmt.Errorf("unknown mail sender %q", kind)
+	}
+}
+
+// LogSender is a sender which only logs the messages, meant to be used for local runs.
+type LogSender struct {
+	logger *slog.Logger
+}
+
+// NewLogSender creates a new sender which logs messages using the logger.
+func NewLogSender(logger *slog.Logger) *LogSender {
+	return &LogSender{logger: logger.With("component", "mail")}
+}
+
+// Send logs the message.
+func (s *LogSender) Send(ctx context.Context, msg Message) error {
+	s.logger.InfoCtx(ctx, "sending mail message",
+		"to", msg.To,
+		"subject", msg.Subject,
+		"body", msg.Body,
+	)
+	return nil
+}
+
+// FileSender is a sender which writes each message to a separate file in a directory, meant to be used for local runs.
+type FileSender struct {
+	dir     string
+	counter atomic.Uint64
+}
+
+// NewFileSender creates a new sender which writes messages to the directory, creating it if needed.
+func NewFileSender(dir string) (*FileSender, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("creating mail directory %s: %w", dir, err)
+	}
+
+	return &FileSender{dir: dir}, nil
+}
+
+// Send writes the message to a new file named after the current time and the recipient.
+func (s *FileSender) Send(_ context.Context, msg Message) error {
+	name := fmt.Sprintf("%s-%d-%s.txt",
+		time.Now().UTC().Format("20060102T150405"),
+		s.counter.Add(1),
+		strings.NewReplacer("/", "_", string(filepath.Separator), "_").Replace(msg.To),
+	)
+
+	content := fmt.Sprintf("To: %s\nSubject: %s\n\n%s\n", msg.To, msg.Subject, msg.Body)
+	if err := os.WriteFile(filepath.Join(s.dir, name), []byte(content), 0o600); err != nil {
+		return fmt.Errorf("writing mail message to %s: %w", name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,308 @@
+// Package oidc implements the relying party side of the OpenID Connect authorization code flow with PKCE.
+package oidc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+)
+
+const (
+	// Allowed clock skew between us and the provider when validating ID tokens
+	clockLeeway = time.Minute
+	// Minimum interval between refetches of the provider's keys when an unknown key ID is encountered
+	keysRefetchInterval = time.Minute
+	// Maximum size of responses read from the provider
+	maxResponseSize = 1 << 20
+)
+
+var (
+	ErrInvalidIDToken = errors.New("invalid id token")
+	ErrExchangeFailed = errors.New("authorization code exchange failed")
+)
+
+// Config describes the OpenID provider and the client registered with it.
+type Config struct {
+	// Name of the provider under which the identities of its users are stored
+	Name string
+	// Issuer URL, used for discovery and for validating ID tokens
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	// RedirectURL to which the provider redirects the user with the authorization code
+	RedirectURL string
+	Scopes      []string
+}
+
+// Claims are the ID token claims used to identify and register users.
+type Claims struct {
+	Subject       string `json:"sub"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	GivenName     string `json:"given_name"`
+	FamilyName    string `json:"family_name"`
+	MiddleName    string `json:"middle_name"`
+	Gender        string `json:"gender"`
+	// Birthdate in the YYYY-MM-DD format
+	Birthdate   string `json:"birthdate"`
+	PhoneNumber string `json:"phone_number"`
+	Nonce       string `json:"nonce"`
+}
+
+// metadata is the part of the provider's discovery document used by the client.
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Provider is a client of a single OpenID provider. The provider's metadata and keys are fetched lazily,
+// so that the provider being unavailable doesn't prevent the service from starting.
+type Provider struct {
+	config     Config
+	httpClient *http.Client
+
+	mu            sync.Mutex
+	metadata      *metadata
+	keys          *jose.JSONWebKeySet
+	keysFetchedAt time.Time
+}
+
+func NewProvider(config Config, httpClient *http.Client) *Provider {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: time.Second * 10}
+	}
+
+	return &Provider{config: config, httpClient: httpClient}
+}
+
+// Name returns the name of the provider under which the identities of its users are stored.
+func (p *Provider) Name() string {
+	return p.config.Name
+}
+
+// CodeChallenge computes the S256 PKCE code challenge for the verifier.
+func CodeChallenge(codeVerifier string) string {
+	hash := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// AuthCodeURL returns the URL of the provider's authorization endpoint to which the user should be sent.
+// The state, nonce and PKCE code verifier must be stored until the user is redirected back.
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	md, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	params := url.Values{}
+	params.Set("response_type", "code")
+	params.Set("client_id", p.config.ClientID)
+	params.Set("redirect_uri", p.config.RedirectURL)
+	params.Set("scope", strings.Join(p.config.Scopes, " "))
+	params.Set("state", state)
+	params.Set("nonce", nonce)
+	params.Set("code_challenge", CodeChallenge(codeVerifier))
+	params.Set("code_challenge_method", "S256")
+
+	authURL, err := url.Parse(md.AuthorizationEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("parsing authorization endpoint: %w", err)
+	}
+
+	// Keep any parameters which are already present in the endpoint
+	query := authURL.Query()
+	for key, values := range params {
+		query[key] = values
+	}
+
+	authURL.RawQuery = query.Encode()
+	return authURL.String(), nil
+}
+
+// Exchange exchanges the authorization code for tokens and returns the claims of the verified ID token.
+func (p *Provider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (Claims, error) {
+	md, err := p.discover(ctx)
+	if err != nil {
+		return Claims{}, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.config.RedirectURL)
+	form.Set("code_verifier", codeVerifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, md.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return Claims{}, fmt.Errorf("creating token request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(p.config.ClientID), url.QueryEscape(p.config.ClientSecret))
+
+	var tokens struct {
+		IDToken string `json:"id_token"`
+		Error   string `json:"error"`
+	}
+
+	if err := p.doJSON(req, &tokens); err != nil {
+		return Claims{}, fmt.Errorf("%w: %v", ErrExchangeFailed, err)
+	} else if tokens.IDToken == "" {
+		return Claims{}, fmt.Errorf("%w: no id token returned", ErrExchangeFailed)
+	}
+
+	return p.verifyIDToken(ctx, md, tokens.IDToken, nonce)
+}
+
+func (p *Provider) verifyIDToken(ctx context.Context, md *metadata, rawToken, nonce string) (Claims, error) {
+	token, err := jwt.ParseSigned(rawToken)
+	if err != nil {
+		return Claims{}, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+	} else if len(token.Headers) != 1 {
+		return Claims{}, fmt.Errorf("%w: expected a single signature", ErrInvalidIDToken)
+	}
+
+	header := token.Headers[0]
+	key, err := p.key(ctx, md, header.KeyID)
+	if err != nil {
+		return Claims{}, err
+	} else if key.Algorithm != "" && key.Algorithm != header.Algorithm {
+		return Claims{}, fmt.Errorf("%w: algorithm %s doesn't match the key", ErrInvalidIDToken, header.Algorithm)
+	}
+
+	var registered jwt.Claims
+	var claims Claims
+	if err := token.Claims(key.Key, &registered, &claims); err != nil {
+		return Claims{}, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+	}
+
+	if registered.Expiry == nil {
+		return Claims{}, fmt.Errorf("%w: no expiry", ErrInvalidIDToken)
+	}
+
+	err = registered.ValidateWithLeeway(jwt.Expected{
+		Issuer:   md.Issuer,
+		Audience: jwt.Audience{p.config.ClientID},
+		Time:     time.Now(),
+	}, clockLeeway)
+	if err != nil {
+		return Claims{}, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+	} else if claims.Subject == "" {
+		return Claims{}, fmt.Errorf("%w: no subject", ErrInvalidIDToken)
+	} else if claims.Nonce != nonce {
+		return Claims{}, fmt.Errorf("%w: nonce mismatch", ErrInvalidIDToken)
+	}
+
+	return claims, nil
+}
+
+// key returns the provider's signing key with the ID, refetching the keys if it isn't known yet.
+func (p *Provider) key(ctx context.Context, md *metadata, keyID string) (jose.JSONWebKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	find := func() (jose.JSONWebKey, bool) {
+		if p.keys == nil {
+			return jose.JSONWebKey{}, false
+		}
+
+		for _, key := range p.keys.Keys {
+			if (keyID == "" || key.KeyID == keyID) && key.Use != "enc" && key.IsPublic() {
+				return key, true
+			}
+		}
+
+		return jose.JSONWebKey{}, false
+	}
+
+	if key, ok := find(); ok {
+		return key, nil
+	} else if time.Since(p.keysFetchedAt) < keysRefetchInterval {
+		return jose.JSONWebKey{}, fmt.Errorf("%w: unknown key %q", ErrInvalidIDToken, keyID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, md.JWKSURI, nil)
+	if err != nil {
+		return jose.JSONWebKey{}, fmt.Errorf("creating keys request: %w", err)
+	}
+
+	var keys jose.JSONWebKeySet
+	if err := p.doJSON(req, &keys); err != nil {
+		return jose.JSONWebKey{}, fmt.Errorf("fetching provider keys: %w", err)
+	}
+
+	p.keys = &keys
+	p.keysFetchedAt = time.Now()
+
+	if key, ok := find(); ok {
+		return key, nil
+	}
+
+	return jose.JSONWebKey{}, fmt.Errorf("%w: unknown key %q", ErrInvalidIDToken, keyID)
+}
+
+// discover fetches the provider's metadata once, retrying on the next call on failure.
+func (p *Provider) discover(ctx context.Context) (*metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.metadata != nil {
+		return p.metadata, nil
+	}
+
+	discoveryURL := strings.TrimSuffix(p.config.Issuer, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating discovery request: %w", err)
+	}
+
+	var md metadata
+	if err := p.doJSON(req, &md); err != nil {
+		return nil, fmt.Errorf("discovering provider metadata: %w", err)
+	}
+
+	if md.Issuer != p.config.Issuer {
+		return nil, fmt.Errorf("provider issuer %q doesn't match the configured one", md.Issuer)
+	} else if md.AuthorizationEndpoint == "" || md.TokenEndpoint == "" || md.JWKSURI == "" {
+		return nil, errors.New("provider metadata is missing required endpoints")
+	}
+
+	p.metadata = &md
+	return p.metadata, nil
+}
+
+func (p *Provider) doJSON(req *http.Request, v any) error {
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return fmt.Errorf("reading response: %w", err)
+	} else if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected response status %d: %s", resp.StatusCode, body)
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package oidc_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+
+	"ldt-hack/api/internal/oidc"
+	"ldt-hack/api/internal/oidc/oidctest"
+)
+
+const (
+	redirectURL  = "https://app.example.com/login/callback"
+	state        = "state-value"
+	nonce        = "nonce-value"
+	codeVerifier = "code-verifier-value-which-is-long-enough"
+)
+
+var testUser = oidc.Claims{
+	Subject:       "user-1",
+	Email:         "user@example.com",
+	EmailVerified: true,
+	GivenName:     "Иван",
+	FamilyName:    "Иванов",
+}
+
+func newTestProvider(t *testing.T) (*oidctest.Server, *oidc.Provider) {
+	t.Helper()
+
+	server, err := oidctest.NewServer("client", "secret", testUser)
+	if err != nil {
+		t.Fatalf("starting provider stub: %v", err)
+	}
+	t.Cleanup(server.Close)
+
+	return server, oidc.NewProvider(server.Config(redirectURL), server.Client())
+}
+
+// authorize starts the login like StartExternalLogin does and returns the code and state passed to the redirect URL.
+func authorize(t *testing.T, server *oidctest.Server, provider *oidc.Provider) (string, string) {
+	t.Helper()
+
+	authURL, err := provider.AuthCodeURL(context.Background(), state, nonce, codeVerifier)
+	if err != nil {
+		t.Fatalf("building authorization url: %v", err)
+	}
+
+	code, returnedState, err := server.Authorize(authURL)
+	if err != nil {
+		t.Fatalf("authorizing: %v", err)
+	}
+
+	return code, returnedState
+}
+
+func TestProviderLogin(t *testing.T) {
+	server, provider := newTestProvider(t)
+
+	code, returnedState := authorize(t, server, provider)
+	if returnedState != state {
+		t.Fatalf("state = %q, want %q", returnedState, state)
+	}
+
+	claims, err := provider.Exchange(context.Background(), code, codeVerifier, nonce)
+	if err != nil {
+		t.Fatalf("exchanging code: %v", err)
+	}
+
+	if claims.Subject != testUser.Subject || claims.Email != testUser.Email || !claims.EmailVerified {
+		t.Errorf("claims = %+v, want the ones of %+v", claims, testUser)
+	}
+}
+
+func TestProviderAuthCodeURL(t *testing.T) {
+	_, provider := newTestProvider(t)
+
+	authURL, err := provider.AuthCodeURL(context.Background(), state, nonce, codeVerifier)
+	if err != nil {
+		t.Fatalf("building authorization url: %v", err)
+	}
+
+	parsed, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatalf("parsing authorization url: %v", err)
+	}
+
+	query := parsed.Query()
+	for param, want := range map[string]string{
+		"state":                 state,
+		"nonce":                 nonce,
+		"redirect_uri":          redirectURL,
+		"code_challenge":        oidc.CodeChallenge(codeVerifier),
+		"code_challenge_method": "S256",
+	} {
+		if got := query.Get(param); got != want {
+			t.Errorf("%s = %q, want %q", param, got, want)
+		}
+	}
+
+	if query.Get("code_challenge") == codeVerifier {
+		t.Error("code verifier is sent in plain text")
+	}
+}
+
+func TestProviderExchangeFailures(t *testing.T) {
+	tests := []struct {
+		name         string
+		codeVerifier string
+		nonce        string
+		want         error
+	}{
+		{"nonce mismatch", codeVerifier, "another-nonce", oidc.ErrInvalidIDToken},
+		{"missing nonce", codeVerifier, "", oidc.ErrInvalidIDToken},
+		{"pkce mismatch", "another-code-verifier-which-is-long-enough", nonce, oidc.ErrExchangeFailed},
+		{"missing pkce verifier", "", nonce, oidc.ErrExchangeFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, provider := newTestProvider(t)
+			code, _ := authorize(t, server, provider)
+
+			if _, err := provider.Exchange(context.Background(), code, tt.codeVerifier, tt.nonce); !errors.Is(err, tt.want) {
+				t.Errorf("error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestProviderExchangeCodeReuse(t *testing.T) {
+	server, provider := newTestProvider(t)
+	code, _ := authorize(t, server, provider)
+
+	if _, err := provider.Exchange(context.Background(), code, codeVerifier, nonce); err != nil {
+		t.Fatalf("exchanging code: %v", err)
+	}
+
+	if _, err := provider.Exchange(context.Background(), code, codeVerifier, nonce); !errors.Is(err, oidc.ErrExchangeFailed) {
+		t.Errorf("error on reuse = %v, want %v", err, oidc.ErrExchangeFailed)
+	}
+}
+
+func TestProviderExchangeWrongClient(t *testing.T) {
+	server, err := oidctest.NewServer("client", "secret", testUser)
+	if err != nil {
+		t.Fatalf("starting provider stub: %v", err)
+	}
+	t.Cleanup(server.Close)
+
+	config := server.Config(redirectURL)
+	config.ClientSecret = "wrong"
+	provider := oidc.NewProvider(config, server.Client())
+
+	code, _ := authorize(t, server, provider)
+	if _, err := provider.Exchange(context.Background(), code, codeVerifier, nonce); !errors.Is(err, oidc.ErrExchangeFailed) {
+		t.Errorf("error = %v, want %v", err, oidc.ErrExchangeFailed)
+	}
+}
+
+// A code completed with the state of another login is exchanged with that login's verifier and nonce,
+// which the provider must reject instead of issuing an ID token for the other user's browser.
+func TestProviderExchangeStateMismatch(t *testing.T) {
+	server, provider := newTestProvider(t)
+	code, _ := authorize(t, server, provider)
+
+	const otherState, otherNonce, otherCodeVerifier = "other-state", "other-nonce", "other-code-verifier-which-is-long-enough"
+	if _, err := provider.AuthCodeURL(context.Background(), otherState, otherNonce, otherCodeVerifier); err != nil {
+		t.Fatalf("building authorization url: %v", err)
+	}
+
+	if _, err := provider.Exchange(context.Background(), code, otherCodeVerifier, otherNonce); !errors.Is(err, oidc.ErrExchangeFailed) {
+		t.Errorf("error = %v, want %v", err, oidc.ErrExchangeFailed)
+	}
+}
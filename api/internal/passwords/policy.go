@@ -0,0 +1,114 @@
+package passwords
+
+import (
+	"bufio"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// commonPasswords is the built-in deny-list of the most common passwords
+//
+//go:embed common.txt
+var commonPasswords string
+
+// Policy validates new passwords set by users and inspectors.
+type Policy struct {
+	minLength int
+	maxLength int
+	// Minimum number of different character classes (lowercase, uppercase, digits, other symbols)
+	minClasses int
+	denied     map[string]struct{}
+}
+
+// NewPolicy creates a new password policy. Along with the built-in deny-list, passwords from the file
+// at denyListPath are denied if the path is set. The file must contain a single password on each line.
+func NewPolicy(minLength, maxLength, minClasses int, denyListPath string) (*Policy, error) {
+	if minLength < 1 || maxLength < minLength {
+		return nil, fmt.Errorf("invalid password length range %d..%d", minLength, maxLength)
+	} else if minClasses < 0 || minClasses > 4 {
+		return nil, fmt.Errorf("invalid number of required character classes %d", minClasses)
+	}
+
+	policy := &Policy{
+		minLength:  minLength,
+		maxLength:  maxLength,
+		minClasses: minClasses,
+		denied:     make(map[string]struct{}),
+	}
+
+	if err := policy.addDenied(strings.NewReader(commonPasswords)); err != nil {
+		return nil, fmt.Errorf("reading built-in deny-list: %w", err)
+	}
+
+	if denyListPath != "" {
+		f, err := os.Open(denyListPath)
+		if err != nil {
+			return nil, fmt.Errorf("opening deny-list: %w", err)
+		}
+		defer f.Close()
+
+		if err := policy.addDenied(f); err != nil {
+			return nil, fmt.Errorf("reading deny-list: %w", err)
+		}
+	}
+
+	return policy, nil
+}
+
+// Validate checks that the password satisfies the policy, returning an error
+// with a message which can be shown to the user if it doesn't.
+func (p *Policy) Validate(password string) error {
+	if length := utf8.RuneCountInString(password); length < p.minLength || length > p.maxLength {
+		return fmt.Errorf("Пароль должен быть длиной от %d до %d символов", p.minLength, p.maxLength)
+	} else if characterClasses(password) < p.minClasses {
+		return fmt.Errorf("Пароль должен содержать символы хотя бы %d типов из следующих: "+
+			"строчные буквы, заглавные буквы, цифры, специальные символы", p.minClasses)
+	} else if _, ok := p.denied[strings.ToLower(password)]; ok {
+		return errors.New("Пароль слишком распространен, выберите другой")
+	}
+
+	return nil
+}
+
+func (p *Policy) addDenied(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if password := strings.TrimSpace(scanner.Text()); password != "" {
+			p.denied[strings.ToLower(password)] = struct{}{}
+		}
+	}
+
+	return scanner.Err()
+}
+
+// characterClasses counts the number of different character classes used in the password.
+func characterClasses(password string) int {
+	var lower, upper, digit, other bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			other = true
+		}
+	}
+
+	count := 0
+	for _, used := range []bool{lower, upper, digit, other} {
+		if used {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,95 @@
+package passwords
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPolicyValidate(t *testing.T) {
+	denyList := filepath.Join(t.TempDir(), "denied.txt")
+	if err := os.WriteFile(denyList, []byte("Company2023!\n\n  local-Secret9  \n"), 0o600); err != nil {
+		t.Fatalf("writing deny-list: %v", err)
+	}
+
+	policy, err := NewPolicy(8, 16, 2, denyList)
+	if err != nil {
+		t.Fatalf("creating policy: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		password string
+		valid    bool
+	}{
+		{"valid", "river-stone", true},
+		{"too short", "ab12cd3", false},
+		{"minimum length", "ab12cd34", true},
+		{"maximum length", "abcdefgh12345678", true},
+		{"too long", "abcdefgh123456789", false},
+		{"length in runes", "пароль12", true},
+		{"short in runes", "пароль1", false},
+		{"single class", "riverstone", false},
+		{"single class unicode", "парольпароль", false},
+		{"lower and upper", "RiverStone", true},
+		{"digits and symbols", "1234-5678", true},
+		{"built-in deny-list", "password1", false},
+		{"built-in deny-list case", "PASSWORD1", false},
+		{"custom deny-list", "company2023!", false},
+		{"custom deny-list trimmed", "LOCAL-secret9", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Validate(tt.password)
+			if tt.valid && err != nil {
+				t.Errorf("Validate(%q) = %v, want no error", tt.password, err)
+			} else if !tt.valid && err == nil {
+				t.Errorf("Validate(%q) accepted the password", tt.password)
+			}
+		})
+	}
+}
+
+func TestCharacterClasses(t *testing.T) {
+	tests := []struct {
+		password string
+		want     int
+	}{
+		{"", 0},
+		{"abc", 1},
+		{"abcDEF", 2},
+		{"abcDEF123", 3},
+		{"abcDEF123!", 4},
+		{"ПарольЁ", 2},
+		{"  ", 1},
+	}
+
+	for _, tt := range tests {
+		if got := characterClasses(tt.password); got != tt.want {
+			t.Errorf("characterClasses(%q) = %d, want %d", tt.password, got, tt.want)
+		}
+	}
+}
+
+func TestNewPolicyRejectsInvalidSettings(t *testing.T) {
+	tests := []struct {
+		name                             string
+		minLength, maxLength, minClasses int
+		denyListPath                     string
+	}{
+		{"zero min length", 0, 16, 2, ""},
+		{"max below min", 8, 4, 2, ""},
+		{"negative classes", 8, 16, -1, ""},
+		{"too many classes", 8, 16, 5, ""},
+		{"missing deny-list", 8, 16, 2, filepath.Join(t.TempDir(), "missing.txt")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewPolicy(tt.minLength, tt.maxLength, tt.minClasses, tt.denyListPath); err == nil {
+				t.Error("invalid policy created")
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ package v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
@@ -69,6 +70,60 @@ func (PersonSex) EnumDescriptor() ([]byte, []int) {
 	return file_api_app_v1_app_proto_rawDescGZIP(), []int{0}
 }
 
+// Represents the second step required to complete the login.
+type TwoFactorStep int32
+
+const (
+	// The token is a complete session token.
+	TwoFactorStep_TWO_FACTOR_STEP_NONE TwoFactorStep = 0
+	// A code must be verified via VerifyTwoFactor.
+	TwoFactorStep_TWO_FACTOR_STEP_VERIFY TwoFactorStep = 1
+	// Two-factor authentication is required by the user's authority, and must be enabled via
+	// SetupTwoFactor and EnableTwoFactor before calling VerifyTwoFactor.
+	TwoFactorStep_TWO_FACTOR_STEP_ENROLL TwoFactorStep = 2
+)
+
+// Enum value maps for TwoFactorStep.
+var (
+	TwoFactorStep_name = map[int32]string{
+		0: "TWO_FACTOR_STEP_NONE",
+		1: "TWO_FACTOR_STEP_VERIFY",
+		2: "TWO_FACTOR_STEP_ENROLL",
+	}
+	TwoFactorStep_value = map[string]int32{
+		"TWO_FACTOR_STEP_NONE":   0,
+		"TWO_FACTOR_STEP_VERIFY": 1,
+		"TWO_FACTOR_STEP_ENROLL": 2,
+	}
+)
+
+func (x TwoFactorStep) Enum() *TwoFactorStep {
+	p := new(TwoFactorStep)
+	*p = x
+	return p
+}
+
+func (x TwoFactorStep) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TwoFactorStep) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_app_v1_app_proto_enumTypes[1].Descriptor()
+}
+
+func (TwoFactorStep) Type() protoreflect.EnumType {
+	return &file_api_app_v1_app_proto_enumTypes[1]
+}
+
+func (x TwoFactorStep) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TwoFactorStep.Descriptor instead.
+func (TwoFactorStep) EnumDescriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{1}
+}
+
 type CreateSessionRequest_SessionUser int32
 
 const (
@@ -99,11 +154,11 @@ func (x CreateSessionRequest_SessionUser) String() string {
 }
 
 func (CreateSessionRequest_SessionUser) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_app_v1_app_proto_enumTypes[1].Descriptor()
+	return file_api_app_v1_app_proto_enumTypes[2].Descriptor()
 }
 
 func (CreateSessionRequest_SessionUser) Type() protoreflect.EnumType {
-	return &file_api_app_v1_app_proto_enumTypes[1]
+	return &file_api_app_v1_app_proto_enumTypes[2]
 }
 
 func (x CreateSessionRequest_SessionUser) Number() protoreflect.EnumNumber {
@@ -112,7 +167,7 @@ func (x CreateSessionRequest_SessionUser) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CreateSessionRequest_SessionUser.Descriptor instead.
 func (CreateSessionRequest_SessionUser) EnumDescriptor() ([]byte, []int) {
-	return file_api_app_v1_app_proto_rawDescGZIP(), []int{5, 0}
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{6, 0}
 }
 
 type RateChatBotRequest_Rating int32
@@ -148,11 +203,11 @@ func (x RateChatBotRequest_Rating) String() string {
 }
 
 func (RateChatBotRequest_Rating) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_app_v1_app_proto_enumTypes[2].Descriptor()
+	return file_api_app_v1_app_proto_enumTypes[3].Descriptor()
 }
 
 func (RateChatBotRequest_Rating) Type() protoreflect.EnumType {
-	return &file_api_app_v1_app_proto_enumTypes[2]
+	return &file_api_app_v1_app_proto_enumTypes[3]
 }
 
 func (x RateChatBotRequest_Rating) Number() protoreflect.EnumNumber {
@@ -161,7 +216,125 @@ func (x RateChatBotRequest_Rating) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RateChatBotRequest_Rating.Descriptor instead.
 func (RateChatBotRequest_Rating) EnumDescriptor() ([]byte, []int) {
-	return file_api_app_v1_app_proto_rawDescGZIP(), []int{9, 0}
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{26, 0}
+}
+
+type ListConsultationAppointmentsResponse_Canceler int32
+
+const (
+	// The appointment hasn't been canceled.
+	ListConsultationAppointmentsResponse_CANCELER_NONE      ListConsultationAppointmentsResponse_Canceler = 0
+	ListConsultationAppointmentsResponse_CANCELER_BUSINESS  ListConsultationAppointmentsResponse_Canceler = 1
+	ListConsultationAppointmentsResponse_CANCELER_AUTHORITY ListConsultationAppointmentsResponse_Canceler = 2
+)
+
+// Enum value maps for ListConsultationAppointmentsResponse_Canceler.
+var (
+	ListConsultationAppointmentsResponse_Canceler_name = map[int32]string{
+		0: "CANCELER_NONE",
+		1: "CANCELER_BUSINESS",
+		2: "CANCELER_AUTHORITY",
+	}
+	ListConsultationAppointmentsResponse_Canceler_value = map[string]int32{
+		"CANCELER_NONE":      0,
+		"CANCELER_BUSINESS":  1,
+		"CANCELER_AUTHORITY": 2,
+	}
+)
+
+func (x ListConsultationAppointmentsResponse_Canceler) Enum() *ListConsultationAppointmentsResponse_Canceler {
+	p := new(ListConsultationAppointmentsResponse_Canceler)
+	*p = x
+	return p
+}
+
+func (x ListConsultationAppointmentsResponse_Canceler) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListConsultationAppointmentsResponse_Canceler) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_app_v1_app_proto_enumTypes[4].Descriptor()
+}
+
+func (ListConsultationAppointmentsResponse_Canceler) Type() protoreflect.EnumType {
+	return &file_api_app_v1_app_proto_enumTypes[4]
+}
+
+func (x ListConsultationAppointmentsResponse_Canceler) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListConsultationAppointmentsResponse_Canceler.Descriptor instead.
+func (ListConsultationAppointmentsResponse_Canceler) EnumDescriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{42, 0}
+}
+
+// AuthOptions describe the access policy of an AppService method, which is checked before the request is handled.
+// Every method must specify its policy, otherwise the server refuses to start.
+type AuthOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Public methods can be called without a session token.
+	Public bool `protobuf:"varint,1,opt,name=public,proto3" json:"public,omitempty"`
+	// Account types which are allowed to call the method, all types are allowed if none are specified.
+	AccountTypes []string `protobuf:"bytes,2,rep,name=account_types,json=accountTypes,proto3" json:"account_types,omitempty"`
+	// Permissions which must all be granted to the session calling the method.
+	Permissions []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+}
+
+func (x *AuthOptions) Reset() {
+	*x = AuthOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthOptions) ProtoMessage() {}
+
+func (x *AuthOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthOptions.ProtoReflect.Descriptor instead.
+func (*AuthOptions) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuthOptions) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *AuthOptions) GetAccountTypes() []string {
+	if x != nil {
+		return x.AccountTypes
+	}
+	return nil
+}
+
+func (x *AuthOptions) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
 }
 
 // Represents all of the information related to a business user.
@@ -182,7 +355,7 @@ type BusinessUser struct {
 func (x *BusinessUser) Reset() {
 	*x = BusinessUser{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_v1_app_proto_msgTypes[0]
+		mi := &file_api_app_v1_app_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -195,7 +368,7 @@ func (x *BusinessUser) String() string {
 func (*BusinessUser) ProtoMessage() {}
 
 func (x *BusinessUser) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_v1_app_proto_msgTypes[0]
+	mi := &file_api_app_v1_app_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -208,7 +381,7 @@ func (x *BusinessUser) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BusinessUser.ProtoReflect.Descriptor instead.
 func (*BusinessUser) Descriptor() ([]byte, []int) {
-	return file_api_app_v1_app_proto_rawDescGZIP(), []int{0}
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *BusinessUser) GetFirstName() string {
@@ -274,7 +447,7 @@ type AuthorityUser struct {
 func (x *AuthorityUser) Reset() {
 	*x = AuthorityUser{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_v1_app_proto_msgTypes[1]
+		mi := &file_api_app_v1_app_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -287,7 +460,7 @@ func (x *AuthorityUser) String() string {
 func (*AuthorityUser) ProtoMessage() {}
 
 func (x *AuthorityUser) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_v1_app_proto_msgTypes[1]
+	mi := &file_api_app_v1_app_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -300,7 +473,7 @@ func (x *AuthorityUser) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthorityUser.ProtoReflect.Descriptor instead.
 func (*AuthorityUser) Descriptor() ([]byte, []int) {
-	return file_api_app_v1_app_proto_rawDescGZIP(), []int{1}
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *AuthorityUser) GetFirstName() string {
@@ -325,18 +498,25 @@ func (x *AuthorityUser) GetAuthorityName() string {
 }
 
 // Session token required for authenticated requests after session has been created.
+// The token is short-lived, and once it expires a new one must be retrieved via RefreshSession
+// using the long-lived refresh token.
 type SessionToken struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Token        string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RefreshToken string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	// Set for partial tokens returned by CreateSession, which can only be used to complete the two-factor authentication.
+	// Refresh tokens aren't returned along with partial tokens.
+	TwoFactorStep TwoFactorStep `protobuf:"varint,4,opt,name=two_factor_step,json=twoFactorStep,proto3,enum=ldt_hack.app.v1.TwoFactorStep" json:"two_factor_step,omitempty"`
 }
 
 func (x *SessionToken) Reset() {
 	*x = SessionToken{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_v1_app_proto_msgTypes[2]
+		mi := &file_api_app_v1_app_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -349,7 +529,7 @@ func (x *SessionToken) String() string {
 func (*SessionToken) ProtoMessage() {}
 
 func (x *SessionToken) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_v1_app_proto_msgTypes[2]
+	mi := &file_api_app_v1_app_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -362,7 +542,7 @@ func (x *SessionToken) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SessionToken.ProtoReflect.Descriptor instead.
 func (*SessionToken) Descriptor() ([]byte, []int) {
-	return file_api_app_v1_app_proto_rawDescGZIP(), []int{2}
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SessionToken) GetToken() string {
@@ -372,6 +552,27 @@ func (x *SessionToken) GetToken() string {
 	return ""
 }
 
+func (x *SessionToken) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *SessionToken) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *SessionToken) GetTwoFactorStep() TwoFactorStep {
+	if x != nil {
+		return x.TwoFactorStep
+	}
+	return TwoFactorStep_TWO_FACTOR_STEP_NONE
+}
+
 // The business user creation request.
 type CreateBusinessUserRequest struct {
 	state         protoimpl.MessageState
@@ -386,7 +587,7 @@ type CreateBusinessUserRequest struct {
 func (x *CreateBusinessUserRequest) Reset() {
 	*x = CreateBusinessUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_v1_app_proto_msgTypes[3]
+		mi := &file_api_app_v1_app_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -399,7 +600,7 @@ func (x *CreateBusinessUserRequest) String() string {
 func (*CreateBusinessUserRequest) ProtoMessage() {}
 
 func (x *CreateBusinessUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_v1_app_proto_msgTypes[3]
+	mi := &file_api_app_v1_app_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -412,7 +613,7 @@ func (x *CreateBusinessUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBusinessUserRequest.ProtoReflect.Descriptor instead.
 func (*CreateBusinessUserRequest) Descriptor() ([]byte, []int) {
-	return file_api_app_v1_app_proto_rawDescGZIP(), []int{3}
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateBusinessUserRequest) GetEmail() string {
@@ -449,7 +650,7 @@ type UpdateBusinessUserRequest struct {
 func (x *UpdateBusinessUserRequest) Reset() {
 	*x = UpdateBusinessUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_v1_app_proto_msgTypes[4]
+		mi := &file_api_app_v1_app_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -462,7 +663,7 @@ func (x *UpdateBusinessUserRequest) String() string {
 func (*UpdateBusinessUserRequest) ProtoMessage() {}
 
 func (x *UpdateBusinessUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_v1_app_proto_msgTypes[4]
+	mi := &file_api_app_v1_app_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -475,7 +676,7 @@ func (x *UpdateBusinessUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateBusinessUserRequest.ProtoReflect.Descriptor instead.
 func (*UpdateBusinessUserRequest) Descriptor() ([]byte, []int) {
-	return file_api_app_v1_app_proto_rawDescGZIP(), []int{4}
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UpdateBusinessUserRequest) GetUser() *BusinessUser {
@@ -499,7 +700,7 @@ type CreateSessionRequest struct {
 func (x *CreateSessionRequest) Reset() {
 	*x = CreateSessionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_v1_app_proto_msgTypes[5]
+		mi := &file_api_app_v1_app_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -512,7 +713,7 @@ func (x *CreateSessionRequest) String() string {
 func (*CreateSessionRequest) ProtoMessage() {}
 
 func (x *CreateSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_v1_app_proto_msgTypes[5]
+	mi := &file_api_app_v1_app_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -525,7 +726,7 @@ func (x *CreateSessionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSessionRequest.ProtoReflect.Descriptor instead.
 func (*CreateSessionRequest) Descriptor() ([]byte, []int) {
-	return file_api_app_v1_app_proto_rawDescGZIP(), []int{5}
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateSessionRequest) GetSessionUser() CreateSessionRequest_SessionUser {
@@ -549,36 +750,32 @@ func (x *CreateSessionRequest) GetPassword() string {
 	return ""
 }
 
-// The session user information retrieval response.
-type GetSessionUserResponse struct {
+// The session refresh request containing the refresh token of an existing session.
+type RefreshSessionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to User:
-	//
-	//	*GetSessionUserResponse_Business
-	//	*GetSessionUserResponse_Authority
-	User isGetSessionUserResponse_User `protobuf_oneof:"user"`
+	RefreshToken string `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 }
 
-func (x *GetSessionUserResponse) Reset() {
-	*x = GetSessionUserResponse{}
+func (x *RefreshSessionRequest) Reset() {
+	*x = RefreshSessionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_v1_app_proto_msgTypes[6]
+		mi := &file_api_app_v1_app_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSessionUserResponse) String() string {
+func (x *RefreshSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSessionUserResponse) ProtoMessage() {}
+func (*RefreshSessionRequest) ProtoMessage() {}
 
-func (x *GetSessionUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_v1_app_proto_msgTypes[6]
+func (x *RefreshSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -589,74 +786,45 @@ func (x *GetSessionUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSessionUserResponse.ProtoReflect.Descriptor instead.
-func (*GetSessionUserResponse) Descriptor() ([]byte, []int) {
-	return file_api_app_v1_app_proto_rawDescGZIP(), []int{6}
-}
-
-func (m *GetSessionUserResponse) GetUser() isGetSessionUserResponse_User {
-	if m != nil {
-		return m.User
-	}
-	return nil
-}
-
-func (x *GetSessionUserResponse) GetBusiness() *BusinessUser {
-	if x, ok := x.GetUser().(*GetSessionUserResponse_Business); ok {
-		return x.Business
-	}
-	return nil
+// Deprecated: Use RefreshSessionRequest.ProtoReflect.Descriptor instead.
+func (*RefreshSessionRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetSessionUserResponse) GetAuthority() *AuthorityUser {
-	if x, ok := x.GetUser().(*GetSessionUserResponse_Authority); ok {
-		return x.Authority
+func (x *RefreshSessionRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
 	}
-	return nil
-}
-
-type isGetSessionUserResponse_User interface {
-	isGetSessionUserResponse_User()
-}
-
-type GetSessionUserResponse_Business struct {
-	Business *BusinessUser `protobuf:"bytes,1,opt,name=business,proto3,oneof"`
-}
-
-type GetSessionUserResponse_Authority struct {
-	Authority *AuthorityUser `protobuf:"bytes,2,opt,name=authority,proto3,oneof"`
+	return ""
 }
 
-func (*GetSessionUserResponse_Business) isGetSessionUserResponse_User() {}
-
-func (*GetSessionUserResponse_Authority) isGetSessionUserResponse_User() {}
-
-// The chat bot message request containing a single message to the bot.
-type SendChatBotMessageRequest struct {
+// The external login start response containing the provider's URL to which the user must be sent.
+type StartExternalLoginResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	AuthorizationUrl string `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl,proto3" json:"authorization_url,omitempty"`
+	State            string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
 }
 
-func (x *SendChatBotMessageRequest) Reset() {
-	*x = SendChatBotMessageRequest{}
+func (x *StartExternalLoginResponse) Reset() {
+	*x = StartExternalLoginResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_v1_app_proto_msgTypes[7]
+		mi := &file_api_app_v1_app_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SendChatBotMessageRequest) String() string {
+func (x *StartExternalLoginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendChatBotMessageRequest) ProtoMessage() {}
+func (*StartExternalLoginResponse) ProtoMessage() {}
 
-func (x *SendChatBotMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_v1_app_proto_msgTypes[7]
+func (x *StartExternalLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -667,45 +835,52 @@ func (x *SendChatBotMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendChatBotMessageRequest.ProtoReflect.Descriptor instead.
-func (*SendChatBotMessageRequest) Descriptor() ([]byte, []int) {
-	return file_api_app_v1_app_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use StartExternalLoginResponse.ProtoReflect.Descriptor instead.
+func (*StartExternalLoginResponse) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *SendChatBotMessageRequest) GetMessage() string {
+func (x *StartExternalLoginResponse) GetAuthorizationUrl() string {
 	if x != nil {
-		return x.Message
+		return x.AuthorizationUrl
 	}
 	return ""
 }
 
-// The chat bot response containing multiple messages from the bot.
-type SendChatBotMessageResponse struct {
+func (x *StartExternalLoginResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+// The external login completion request with the parameters passed by the provider to the redirect URL.
+type CompleteExternalLoginRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Messages []string `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
-	Id       int64    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Code  string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-func (x *SendChatBotMessageResponse) Reset() {
-	*x = SendChatBotMessageResponse{}
+func (x *CompleteExternalLoginRequest) Reset() {
+	*x = CompleteExternalLoginRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_v1_app_proto_msgTypes[8]
+		mi := &file_api_app_v1_app_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SendChatBotMessageResponse) String() string {
+func (x *CompleteExternalLoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendChatBotMessageResponse) ProtoMessage() {}
+func (*CompleteExternalLoginRequest) ProtoMessage() {}
 
-func (x *SendChatBotMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_v1_app_proto_msgTypes[8]
+func (x *CompleteExternalLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -716,92 +891,36 @@ func (x *SendChatBotMessageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendChatBotMessageResponse.ProtoReflect.Descriptor instead.
-func (*SendChatBotMessageResponse) Descriptor() ([]byte, []int) {
-	return file_api_app_v1_app_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CompleteExternalLoginRequest.ProtoReflect.Descriptor instead.
+func (*CompleteExternalLoginRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *SendChatBotMessageResponse) GetMessages() []string {
+func (x *CompleteExternalLoginRequest) GetState() string {
 	if x != nil {
-		return x.Messages
+		return x.State
 	}
-	return nil
+	return ""
 }
 
-func (x *SendChatBotMessageResponse) GetId() int64 {
+func (x *CompleteExternalLoginRequest) GetCode() string {
 	if x != nil {
-		return x.Id
+		return x.Code
 	}
-	return 0
-}
-
-// The chat bot rating request for a previous request+response group.
-type RateChatBotRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id     int64                     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Rating RateChatBotRequest_Rating `protobuf:"varint,2,opt,name=rating,proto3,enum=ldt_hack.app.v1.RateChatBotRequest_Rating" json:"rating,omitempty"`
-}
-
-func (x *RateChatBotRequest) Reset() {
-	*x = RateChatBotRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_v1_app_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RateChatBotRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RateChatBotRequest) ProtoMessage() {}
-
-func (x *RateChatBotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_v1_app_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RateChatBotRequest.ProtoReflect.Descriptor instead.
-func (*RateChatBotRequest) Descriptor() ([]byte, []int) {
-	return file_api_app_v1_app_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *RateChatBotRequest) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *RateChatBotRequest) GetRating() RateChatBotRequest_Rating {
-	if x != nil {
-		return x.Rating
-	}
-	return RateChatBotRequest_RATING_REMOVED
+	return ""
 }
 
-// The consultation topic listing response.
-type ListConsultationTopicsResponse struct {
+// The session listing response, containing information about the currently active sessions.
+type ListSessionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AuthorityTopics []*ListConsultationTopicsResponse_AuthorityTopics `protobuf:"bytes,1,rep,name=authority_topics,json=authorityTopics,proto3" json:"authority_topics,omitempty"`
+	Sessions []*ListSessionsResponse_SessionInfo `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
 }
 
-func (x *ListConsultationTopicsResponse) Reset() {
-	*x = ListConsultationTopicsResponse{}
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_v1_app_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -809,13 +928,13 @@ func (x *ListConsultationTopicsResponse) Reset() {
 	}
 }
 
-func (x *ListConsultationTopicsResponse) String() string {
+func (x *ListSessionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListConsultationTopicsResponse) ProtoMessage() {}
+func (*ListSessionsResponse) ProtoMessage() {}
 
-func (x *ListConsultationTopicsResponse) ProtoReflect() protoreflect.Message {
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_v1_app_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -827,31 +946,29 @@ func (x *ListConsultationTopicsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListConsultationTopicsResponse.ProtoReflect.Descriptor instead.
-func (*ListConsultationTopicsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
 	return file_api_app_v1_app_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ListConsultationTopicsResponse) GetAuthorityTopics() []*ListConsultationTopicsResponse_AuthorityTopics {
+func (x *ListSessionsResponse) GetSessions() []*ListSessionsResponse_SessionInfo {
 	if x != nil {
-		return x.AuthorityTopics
+		return x.Sessions
 	}
 	return nil
 }
 
-// The available consultation date listing request.
-type ListAvailableConsultationDatesRequest struct {
+// The session revocation request.
+type RevokeSessionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AuthorityId int64                  `protobuf:"varint,1,opt,name=authority_id,json=authorityId,proto3" json:"authority_id,omitempty"`
-	FromDate    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
-	ToDate      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ListAvailableConsultationDatesRequest) Reset() {
-	*x = ListAvailableConsultationDatesRequest{}
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_v1_app_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -859,13 +976,13 @@ func (x *ListAvailableConsultationDatesRequest) Reset() {
 	}
 }
 
-func (x *ListAvailableConsultationDatesRequest) String() string {
+func (x *RevokeSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAvailableConsultationDatesRequest) ProtoMessage() {}
+func (*RevokeSessionRequest) ProtoMessage() {}
 
-func (x *ListAvailableConsultationDatesRequest) ProtoReflect() protoreflect.Message {
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_v1_app_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -877,43 +994,30 @@ func (x *ListAvailableConsultationDatesRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAvailableConsultationDatesRequest.ProtoReflect.Descriptor instead.
-func (*ListAvailableConsultationDatesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_v1_app_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListAvailableConsultationDatesRequest) GetAuthorityId() int64 {
-	if x != nil {
-		return x.AuthorityId
-	}
-	return 0
-}
-
-func (x *ListAvailableConsultationDatesRequest) GetFromDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.FromDate
-	}
-	return nil
-}
-
-func (x *ListAvailableConsultationDatesRequest) GetToDate() *timestamppb.Timestamp {
+func (x *RevokeSessionRequest) GetId() string {
 	if x != nil {
-		return x.ToDate
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// The available consultation date listing response.
-type ListAvailableConsultationDatesResponse struct {
+// The password reset request for an existing user account.
+type RequestPasswordResetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AvailableDates []*timestamppb.Timestamp `protobuf:"bytes,2,rep,name=available_dates,json=availableDates,proto3" json:"available_dates,omitempty"`
+	SessionUser CreateSessionRequest_SessionUser `protobuf:"varint,1,opt,name=session_user,json=sessionUser,proto3,enum=ldt_hack.app.v1.CreateSessionRequest_SessionUser" json:"session_user,omitempty"`
+	Email       string                           `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
 }
 
-func (x *ListAvailableConsultationDatesResponse) Reset() {
-	*x = ListAvailableConsultationDatesResponse{}
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_v1_app_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -921,13 +1025,13 @@ func (x *ListAvailableConsultationDatesResponse) Reset() {
 	}
 }
 
-func (x *ListAvailableConsultationDatesResponse) String() string {
+func (x *RequestPasswordResetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAvailableConsultationDatesResponse) ProtoMessage() {}
+func (*RequestPasswordResetRequest) ProtoMessage() {}
 
-func (x *ListAvailableConsultationDatesResponse) ProtoReflect() protoreflect.Message {
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_v1_app_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -939,30 +1043,37 @@ func (x *ListAvailableConsultationDatesResponse) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAvailableConsultationDatesResponse.ProtoReflect.Descriptor instead.
-func (*ListAvailableConsultationDatesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_v1_app_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListAvailableConsultationDatesResponse) GetAvailableDates() []*timestamppb.Timestamp {
+func (x *RequestPasswordResetRequest) GetSessionUser() CreateSessionRequest_SessionUser {
 	if x != nil {
-		return x.AvailableDates
+		return x.SessionUser
 	}
-	return nil
+	return CreateSessionRequest_SESSION_USER_BUSINESS
 }
 
-// The consultation slot listing request.
-type ListAvailableConsultationSlotsRequest struct {
+func (x *RequestPasswordResetRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// The password reset confirmation request containing the received token and the new password.
+type ConfirmPasswordResetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AuthorityId int64                  `protobuf:"varint,1,opt,name=authority_id,json=authorityId,proto3" json:"authority_id,omitempty"`
-	Date        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Token    string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *ListAvailableConsultationSlotsRequest) Reset() {
-	*x = ListAvailableConsultationSlotsRequest{}
+func (x *ConfirmPasswordResetRequest) Reset() {
+	*x = ConfirmPasswordResetRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_v1_app_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -970,13 +1081,13 @@ func (x *ListAvailableConsultationSlotsRequest) Reset() {
 	}
 }
 
-func (x *ListAvailableConsultationSlotsRequest) String() string {
+func (x *ConfirmPasswordResetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAvailableConsultationSlotsRequest) ProtoMessage() {}
+func (*ConfirmPasswordResetRequest) ProtoMessage() {}
 
-func (x *ListAvailableConsultationSlotsRequest) ProtoReflect() protoreflect.Message {
+func (x *ConfirmPasswordResetRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_v1_app_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -988,37 +1099,36 @@ func (x *ListAvailableConsultationSlotsRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAvailableConsultationSlotsRequest.ProtoReflect.Descriptor instead.
-func (*ListAvailableConsultationSlotsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConfirmPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmPasswordResetRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_v1_app_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ListAvailableConsultationSlotsRequest) GetAuthorityId() int64 {
+func (x *ConfirmPasswordResetRequest) GetToken() string {
 	if x != nil {
-		return x.AuthorityId
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-func (x *ListAvailableConsultationSlotsRequest) GetDate() *timestamppb.Timestamp {
+func (x *ConfirmPasswordResetRequest) GetPassword() string {
 	if x != nil {
-		return x.Date
+		return x.Password
 	}
-	return nil
+	return ""
 }
 
-// The consultation slot listing response.
-// from_time and to_time are guaranteed to have the same date as the one that was specified in the request.
-type ListAvailableConsultationSlotsResponse struct {
+// The email verification request containing the received code.
+type VerifyEmailRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ConsultationSlots []*ListAvailableConsultationSlotsResponse_ConsultationSlot `protobuf:"bytes,1,rep,name=consultation_slots,json=consultationSlots,proto3" json:"consultation_slots,omitempty"`
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-func (x *ListAvailableConsultationSlotsResponse) Reset() {
-	*x = ListAvailableConsultationSlotsResponse{}
+func (x *VerifyEmailRequest) Reset() {
+	*x = VerifyEmailRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_v1_app_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1026,13 +1136,13 @@ func (x *ListAvailableConsultationSlotsResponse) Reset() {
 	}
 }
 
-func (x *ListAvailableConsultationSlotsResponse) String() string {
+func (x *VerifyEmailRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAvailableConsultationSlotsResponse) ProtoMessage() {}
+func (*VerifyEmailRequest) ProtoMessage() {}
 
-func (x *ListAvailableConsultationSlotsResponse) ProtoReflect() protoreflect.Message {
+func (x *VerifyEmailRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_v1_app_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1044,31 +1154,30 @@ func (x *ListAvailableConsultationSlotsResponse) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAvailableConsultationSlotsResponse.ProtoReflect.Descriptor instead.
-func (*ListAvailableConsultationSlotsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyEmailRequest.ProtoReflect.Descriptor instead.
+func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_v1_app_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ListAvailableConsultationSlotsResponse) GetConsultationSlots() []*ListAvailableConsultationSlotsResponse_ConsultationSlot {
+func (x *VerifyEmailRequest) GetCode() string {
 	if x != nil {
-		return x.ConsultationSlots
+		return x.Code
 	}
-	return nil
+	return ""
 }
 
-// The consultation appointment creation request. The fields should be filled in using the
-// information recevied via prior requests (ListConsultationTopics, ListAvailableConsultationSlots).
-type CreateConsultationAppointmentRequest struct {
+// The password change request. The current password is required to confirm the change.
+type ChangePasswordRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TopicId int64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	SlotId  int64 `protobuf:"varint,2,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	CurrentPassword string `protobuf:"bytes,1,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	NewPassword     string `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
 }
 
-func (x *CreateConsultationAppointmentRequest) Reset() {
-	*x = CreateConsultationAppointmentRequest{}
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_v1_app_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1076,13 +1185,13 @@ func (x *CreateConsultationAppointmentRequest) Reset() {
 	}
 }
 
-func (x *CreateConsultationAppointmentRequest) String() string {
+func (x *ChangePasswordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateConsultationAppointmentRequest) ProtoMessage() {}
+func (*ChangePasswordRequest) ProtoMessage() {}
 
-func (x *CreateConsultationAppointmentRequest) ProtoReflect() protoreflect.Message {
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_v1_app_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1094,36 +1203,37 @@ func (x *CreateConsultationAppointmentRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateConsultationAppointmentRequest.ProtoReflect.Descriptor instead.
-func (*CreateConsultationAppointmentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_v1_app_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *CreateConsultationAppointmentRequest) GetTopicId() int64 {
+func (x *ChangePasswordRequest) GetCurrentPassword() string {
 	if x != nil {
-		return x.TopicId
+		return x.CurrentPassword
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateConsultationAppointmentRequest) GetSlotId() int64 {
+func (x *ChangePasswordRequest) GetNewPassword() string {
 	if x != nil {
-		return x.SlotId
+		return x.NewPassword
 	}
-	return 0
+	return ""
 }
 
-// The consultation appointment creation response, containing additional information to display to the user.
-type CreateConsultationAppointmentResponse struct {
+// The email change request. The current password is required to confirm the change.
+type ChangeEmailRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Inspector *AuthorityUser `protobuf:"bytes,1,opt,name=inspector,proto3" json:"inspector,omitempty"`
+	NewEmail string `protobuf:"bytes,1,opt,name=new_email,json=newEmail,proto3" json:"new_email,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *CreateConsultationAppointmentResponse) Reset() {
-	*x = CreateConsultationAppointmentResponse{}
+func (x *ChangeEmailRequest) Reset() {
+	*x = ChangeEmailRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_v1_app_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1131,13 +1241,13 @@ func (x *CreateConsultationAppointmentResponse) Reset() {
 	}
 }
 
-func (x *CreateConsultationAppointmentResponse) String() string {
+func (x *ChangeEmailRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateConsultationAppointmentResponse) ProtoMessage() {}
+func (*ChangeEmailRequest) ProtoMessage() {}
 
-func (x *CreateConsultationAppointmentResponse) ProtoReflect() protoreflect.Message {
+func (x *ChangeEmailRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_v1_app_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1149,29 +1259,42 @@ func (x *CreateConsultationAppointmentResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateConsultationAppointmentResponse.ProtoReflect.Descriptor instead.
-func (*CreateConsultationAppointmentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangeEmailRequest.ProtoReflect.Descriptor instead.
+func (*ChangeEmailRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_v1_app_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *CreateConsultationAppointmentResponse) GetInspector() *AuthorityUser {
+func (x *ChangeEmailRequest) GetNewEmail() string {
 	if x != nil {
-		return x.Inspector
+		return x.NewEmail
 	}
-	return nil
+	return ""
 }
 
-// The consultation cancelation request.
-type CancelConsultationAppointmentRequest struct {
+func (x *ChangeEmailRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// The session user information retrieval response.
+type GetSessionUserResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Types that are assignable to User:
+	//
+	//	*GetSessionUserResponse_Business
+	//	*GetSessionUserResponse_Authority
+	User             isGetSessionUserResponse_User `protobuf_oneof:"user"`
+	EmailVerified    bool                          `protobuf:"varint,3,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	TwoFactorEnabled bool                          `protobuf:"varint,4,opt,name=two_factor_enabled,json=twoFactorEnabled,proto3" json:"two_factor_enabled,omitempty"`
 }
 
-func (x *CancelConsultationAppointmentRequest) Reset() {
-	*x = CancelConsultationAppointmentRequest{}
+func (x *GetSessionUserResponse) Reset() {
+	*x = GetSessionUserResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_v1_app_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1179,13 +1302,13 @@ func (x *CancelConsultationAppointmentRequest) Reset() {
 	}
 }
 
-func (x *CancelConsultationAppointmentRequest) String() string {
+func (x *GetSessionUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelConsultationAppointmentRequest) ProtoMessage() {}
+func (*GetSessionUserResponse) ProtoMessage() {}
 
-func (x *CancelConsultationAppointmentRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSessionUserResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_v1_app_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1197,44 +1320,1591 @@ func (x *CancelConsultationAppointmentRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelConsultationAppointmentRequest.ProtoReflect.Descriptor instead.
-func (*CancelConsultationAppointmentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSessionUserResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionUserResponse) Descriptor() ([]byte, []int) {
 	return file_api_app_v1_app_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *CancelConsultationAppointmentRequest) GetId() string {
-	if x != nil {
-		return x.Id
+func (m *GetSessionUserResponse) GetUser() isGetSessionUserResponse_User {
+	if m != nil {
+		return m.User
 	}
-	return ""
+	return nil
 }
 
-// The consultation appointment listing response, containing all of the details about a single consultation appointment.
-type ListConsultationAppointmentsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	AppointmentInfo []*ListConsultationAppointmentsResponse_AppointmentInfo `protobuf:"bytes,1,rep,name=appointment_info,json=appointmentInfo,proto3" json:"appointment_info,omitempty"`
+func (x *GetSessionUserResponse) GetBusiness() *BusinessUser {
+	if x, ok := x.GetUser().(*GetSessionUserResponse_Business); ok {
+		return x.Business
+	}
+	return nil
 }
 
-func (x *ListConsultationAppointmentsResponse) Reset() {
-	*x = ListConsultationAppointmentsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_v1_app_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetSessionUserResponse) GetAuthority() *AuthorityUser {
+	if x, ok := x.GetUser().(*GetSessionUserResponse_Authority); ok {
+		return x.Authority
 	}
+	return nil
 }
 
-func (x *ListConsultationAppointmentsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetSessionUserResponse) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+func (x *GetSessionUserResponse) GetTwoFactorEnabled() bool {
+	if x != nil {
+		return x.TwoFactorEnabled
+	}
+	return false
+}
+
+type isGetSessionUserResponse_User interface {
+	isGetSessionUserResponse_User()
+}
+
+type GetSessionUserResponse_Business struct {
+	Business *BusinessUser `protobuf:"bytes,1,opt,name=business,proto3,oneof"`
+}
+
+type GetSessionUserResponse_Authority struct {
+	Authority *AuthorityUser `protobuf:"bytes,2,opt,name=authority,proto3,oneof"`
+}
+
+func (*GetSessionUserResponse_Business) isGetSessionUserResponse_User() {}
+
+func (*GetSessionUserResponse_Authority) isGetSessionUserResponse_User() {}
+
+// The two-factor authentication setup response, containing the secret to be added to an authenticator app
+// either manually or by encoding the otpauth:// provisioning URI into a QR code.
+type SetupTwoFactorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret          string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	ProvisioningUri string `protobuf:"bytes,2,opt,name=provisioning_uri,json=provisioningUri,proto3" json:"provisioning_uri,omitempty"`
+}
+
+func (x *SetupTwoFactorResponse) Reset() {
+	*x = SetupTwoFactorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetupTwoFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetupTwoFactorResponse) ProtoMessage() {}
+
+func (x *SetupTwoFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetupTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*SetupTwoFactorResponse) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetupTwoFactorResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *SetupTwoFactorResponse) GetProvisioningUri() string {
+	if x != nil {
+		return x.ProvisioningUri
+	}
+	return ""
+}
+
+// The two-factor authentication enrollment confirmation request.
+type EnableTwoFactorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *EnableTwoFactorRequest) Reset() {
+	*x = EnableTwoFactorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableTwoFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableTwoFactorRequest) ProtoMessage() {}
+
+func (x *EnableTwoFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*EnableTwoFactorRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *EnableTwoFactorRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// The two-factor authentication disabling request. Both the password and a code are required to confirm it.
+type DisableTwoFactorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Code     string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *DisableTwoFactorRequest) Reset() {
+	*x = DisableTwoFactorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisableTwoFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTwoFactorRequest) ProtoMessage() {}
+
+func (x *DisableTwoFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*DisableTwoFactorRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DisableTwoFactorRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *DisableTwoFactorRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// The recovery codes regeneration request. A code from the authenticator app or a recovery code is required.
+type RegenerateRecoveryCodesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *RegenerateRecoveryCodesRequest) Reset() {
+	*x = RegenerateRecoveryCodesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegenerateRecoveryCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateRecoveryCodesRequest) ProtoMessage() {}
+
+func (x *RegenerateRecoveryCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateRecoveryCodesRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateRecoveryCodesRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RegenerateRecoveryCodesRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// One-time recovery codes which can be used once each in place of codes from the authenticator app.
+// The codes are only returned once, and aren't retrievable later.
+type TwoFactorRecoveryCodes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecoveryCodes []string `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+}
+
+func (x *TwoFactorRecoveryCodes) Reset() {
+	*x = TwoFactorRecoveryCodes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TwoFactorRecoveryCodes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TwoFactorRecoveryCodes) ProtoMessage() {}
+
+func (x *TwoFactorRecoveryCodes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TwoFactorRecoveryCodes.ProtoReflect.Descriptor instead.
+func (*TwoFactorRecoveryCodes) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TwoFactorRecoveryCodes) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+// The second login step request with a code from the authenticator app or a recovery code.
+type VerifyTwoFactorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *VerifyTwoFactorRequest) Reset() {
+	*x = VerifyTwoFactorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyTwoFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTwoFactorRequest) ProtoMessage() {}
+
+func (x *VerifyTwoFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*VerifyTwoFactorRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *VerifyTwoFactorRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// The chat bot message request containing a single message to the bot.
+type SendChatBotMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *SendChatBotMessageRequest) Reset() {
+	*x = SendChatBotMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendChatBotMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatBotMessageRequest) ProtoMessage() {}
+
+func (x *SendChatBotMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatBotMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendChatBotMessageRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SendChatBotMessageRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// The chat bot response containing multiple messages from the bot.
+type SendChatBotMessageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages []string `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	Id       int64    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *SendChatBotMessageResponse) Reset() {
+	*x = SendChatBotMessageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendChatBotMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatBotMessageResponse) ProtoMessage() {}
+
+func (x *SendChatBotMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatBotMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendChatBotMessageResponse) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SendChatBotMessageResponse) GetMessages() []string {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *SendChatBotMessageResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// The chat bot rating request for a previous request+response group.
+type RateChatBotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64                     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Rating RateChatBotRequest_Rating `protobuf:"varint,2,opt,name=rating,proto3,enum=ldt_hack.app.v1.RateChatBotRequest_Rating" json:"rating,omitempty"`
+}
+
+func (x *RateChatBotRequest) Reset() {
+	*x = RateChatBotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateChatBotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateChatBotRequest) ProtoMessage() {}
+
+func (x *RateChatBotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateChatBotRequest.ProtoReflect.Descriptor instead.
+func (*RateChatBotRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RateChatBotRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RateChatBotRequest) GetRating() RateChatBotRequest_Rating {
+	if x != nil {
+		return x.Rating
+	}
+	return RateChatBotRequest_RATING_REMOVED
+}
+
+// The consultation topic listing response.
+type ListConsultationTopicsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthorityTopics []*ListConsultationTopicsResponse_AuthorityTopics `protobuf:"bytes,1,rep,name=authority_topics,json=authorityTopics,proto3" json:"authority_topics,omitempty"`
+}
+
+func (x *ListConsultationTopicsResponse) Reset() {
+	*x = ListConsultationTopicsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConsultationTopicsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConsultationTopicsResponse) ProtoMessage() {}
+
+func (x *ListConsultationTopicsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConsultationTopicsResponse.ProtoReflect.Descriptor instead.
+func (*ListConsultationTopicsResponse) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListConsultationTopicsResponse) GetAuthorityTopics() []*ListConsultationTopicsResponse_AuthorityTopics {
+	if x != nil {
+		return x.AuthorityTopics
+	}
+	return nil
+}
+
+// The available consultation date listing request.
+type ListAvailableConsultationDatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthorityId int64                  `protobuf:"varint,1,opt,name=authority_id,json=authorityId,proto3" json:"authority_id,omitempty"`
+	FromDate    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	ToDate      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	// Only the inspectors competent in the topic are considered if it's specified.
+	TopicId int64 `protobuf:"varint,4,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+}
+
+func (x *ListAvailableConsultationDatesRequest) Reset() {
+	*x = ListAvailableConsultationDatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAvailableConsultationDatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAvailableConsultationDatesRequest) ProtoMessage() {}
+
+func (x *ListAvailableConsultationDatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAvailableConsultationDatesRequest.ProtoReflect.Descriptor instead.
+func (*ListAvailableConsultationDatesRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListAvailableConsultationDatesRequest) GetAuthorityId() int64 {
+	if x != nil {
+		return x.AuthorityId
+	}
+	return 0
+}
+
+func (x *ListAvailableConsultationDatesRequest) GetFromDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromDate
+	}
+	return nil
+}
+
+func (x *ListAvailableConsultationDatesRequest) GetToDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ToDate
+	}
+	return nil
+}
+
+func (x *ListAvailableConsultationDatesRequest) GetTopicId() int64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+// The available consultation date listing response.
+type ListAvailableConsultationDatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AvailableDates []*timestamppb.Timestamp `protobuf:"bytes,2,rep,name=available_dates,json=availableDates,proto3" json:"available_dates,omitempty"`
+}
+
+func (x *ListAvailableConsultationDatesResponse) Reset() {
+	*x = ListAvailableConsultationDatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAvailableConsultationDatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAvailableConsultationDatesResponse) ProtoMessage() {}
+
+func (x *ListAvailableConsultationDatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAvailableConsultationDatesResponse.ProtoReflect.Descriptor instead.
+func (*ListAvailableConsultationDatesResponse) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListAvailableConsultationDatesResponse) GetAvailableDates() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.AvailableDates
+	}
+	return nil
+}
+
+// The consultation slot listing request.
+type ListAvailableConsultationSlotsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthorityId int64                  `protobuf:"varint,1,opt,name=authority_id,json=authorityId,proto3" json:"authority_id,omitempty"`
+	Date        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	// Only the inspectors competent in the topic are considered if it's specified.
+	TopicId int64 `protobuf:"varint,3,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+}
+
+func (x *ListAvailableConsultationSlotsRequest) Reset() {
+	*x = ListAvailableConsultationSlotsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAvailableConsultationSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAvailableConsultationSlotsRequest) ProtoMessage() {}
+
+func (x *ListAvailableConsultationSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAvailableConsultationSlotsRequest.ProtoReflect.Descriptor instead.
+func (*ListAvailableConsultationSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListAvailableConsultationSlotsRequest) GetAuthorityId() int64 {
+	if x != nil {
+		return x.AuthorityId
+	}
+	return 0
+}
+
+func (x *ListAvailableConsultationSlotsRequest) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *ListAvailableConsultationSlotsRequest) GetTopicId() int64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+// The consultation slot listing response.
+// from_time and to_time are guaranteed to have the same date as the one that was specified in the request.
+type ListAvailableConsultationSlotsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsultationSlots []*ListAvailableConsultationSlotsResponse_ConsultationSlot `protobuf:"bytes,1,rep,name=consultation_slots,json=consultationSlots,proto3" json:"consultation_slots,omitempty"`
+}
+
+func (x *ListAvailableConsultationSlotsResponse) Reset() {
+	*x = ListAvailableConsultationSlotsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAvailableConsultationSlotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAvailableConsultationSlotsResponse) ProtoMessage() {}
+
+func (x *ListAvailableConsultationSlotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAvailableConsultationSlotsResponse.ProtoReflect.Descriptor instead.
+func (*ListAvailableConsultationSlotsResponse) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListAvailableConsultationSlotsResponse) GetConsultationSlots() []*ListAvailableConsultationSlotsResponse_ConsultationSlot {
+	if x != nil {
+		return x.ConsultationSlots
+	}
+	return nil
+}
+
+// The consultation slot hold request.
+type HoldConsultationSlotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SlotId int64 `protobuf:"varint,1,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	// Only the inspectors competent in the topic are held if it's specified.
+	TopicId int64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+}
+
+func (x *HoldConsultationSlotRequest) Reset() {
+	*x = HoldConsultationSlotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HoldConsultationSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldConsultationSlotRequest) ProtoMessage() {}
+
+func (x *HoldConsultationSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldConsultationSlotRequest.ProtoReflect.Descriptor instead.
+func (*HoldConsultationSlotRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *HoldConsultationSlotRequest) GetSlotId() int64 {
+	if x != nil {
+		return x.SlotId
+	}
+	return 0
+}
+
+func (x *HoldConsultationSlotRequest) GetTopicId() int64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+// The consultation slot hold response.
+type HoldConsultationSlotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HoldToken string                 `protobuf:"bytes,1,opt,name=hold_token,json=holdToken,proto3" json:"hold_token,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *HoldConsultationSlotResponse) Reset() {
+	*x = HoldConsultationSlotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HoldConsultationSlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldConsultationSlotResponse) ProtoMessage() {}
+
+func (x *HoldConsultationSlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldConsultationSlotResponse.ProtoReflect.Descriptor instead.
+func (*HoldConsultationSlotResponse) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *HoldConsultationSlotResponse) GetHoldToken() string {
+	if x != nil {
+		return x.HoldToken
+	}
+	return ""
+}
+
+func (x *HoldConsultationSlotResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// The consultation appointment creation request. The fields should be filled in using the
+// information recevied via prior requests (ListConsultationTopics, ListAvailableConsultationSlots).
+type CreateConsultationAppointmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId int64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	SlotId  int64 `protobuf:"varint,2,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	// Token of the slot's hold retrieved via HoldConsultationSlot, if any.
+	HoldToken string `protobuf:"bytes,3,opt,name=hold_token,json=holdToken,proto3" json:"hold_token,omitempty"`
+	// Optional details for the inspector about what should be discussed during the consultation.
+	Question    string                          `protobuf:"bytes,4,opt,name=question,proto3" json:"question,omitempty"`
+	Attachments []*ConsultationAttachmentUpload `protobuf:"bytes,5,rep,name=attachments,proto3" json:"attachments,omitempty"`
+}
+
+func (x *CreateConsultationAppointmentRequest) Reset() {
+	*x = CreateConsultationAppointmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateConsultationAppointmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConsultationAppointmentRequest) ProtoMessage() {}
+
+func (x *CreateConsultationAppointmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateConsultationAppointmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateConsultationAppointmentRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreateConsultationAppointmentRequest) GetTopicId() int64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *CreateConsultationAppointmentRequest) GetSlotId() int64 {
+	if x != nil {
+		return x.SlotId
+	}
+	return 0
+}
+
+func (x *CreateConsultationAppointmentRequest) GetHoldToken() string {
+	if x != nil {
+		return x.HoldToken
+	}
+	return ""
+}
+
+func (x *CreateConsultationAppointmentRequest) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *CreateConsultationAppointmentRequest) GetAttachments() []*ConsultationAttachmentUpload {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+// A file attached to a consultation appointment during its creation.
+type ConsultationAttachmentUpload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content     []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *ConsultationAttachmentUpload) Reset() {
+	*x = ConsultationAttachmentUpload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsultationAttachmentUpload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsultationAttachmentUpload) ProtoMessage() {}
+
+func (x *ConsultationAttachmentUpload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsultationAttachmentUpload.ProtoReflect.Descriptor instead.
+func (*ConsultationAttachmentUpload) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ConsultationAttachmentUpload) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConsultationAttachmentUpload) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ConsultationAttachmentUpload) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// The consultation appointment creation response, containing additional information to display to the user.
+type CreateConsultationAppointmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Inspector *AuthorityUser `protobuf:"bytes,1,opt,name=inspector,proto3" json:"inspector,omitempty"`
+}
+
+func (x *CreateConsultationAppointmentResponse) Reset() {
+	*x = CreateConsultationAppointmentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateConsultationAppointmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConsultationAppointmentResponse) ProtoMessage() {}
+
+func (x *CreateConsultationAppointmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateConsultationAppointmentResponse.ProtoReflect.Descriptor instead.
+func (*CreateConsultationAppointmentResponse) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateConsultationAppointmentResponse) GetInspector() *AuthorityUser {
+	if x != nil {
+		return x.Inspector
+	}
+	return nil
+}
+
+// The consultation cancelation request.
+type CancelConsultationAppointmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Explanation shown to the other participant, required for authority users.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *CancelConsultationAppointmentRequest) Reset() {
+	*x = CancelConsultationAppointmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelConsultationAppointmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelConsultationAppointmentRequest) ProtoMessage() {}
+
+func (x *CancelConsultationAppointmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelConsultationAppointmentRequest.ProtoReflect.Descriptor instead.
+func (*CancelConsultationAppointmentRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CancelConsultationAppointmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelConsultationAppointmentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// The consultation rescheduling request.
+type RescheduleConsultationAppointmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NewSlotId int64  `protobuf:"varint,2,opt,name=new_slot_id,json=newSlotId,proto3" json:"new_slot_id,omitempty"`
+}
+
+func (x *RescheduleConsultationAppointmentRequest) Reset() {
+	*x = RescheduleConsultationAppointmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RescheduleConsultationAppointmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleConsultationAppointmentRequest) ProtoMessage() {}
+
+func (x *RescheduleConsultationAppointmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleConsultationAppointmentRequest.ProtoReflect.Descriptor instead.
+func (*RescheduleConsultationAppointmentRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RescheduleConsultationAppointmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RescheduleConsultationAppointmentRequest) GetNewSlotId() int64 {
+	if x != nil {
+		return x.NewSlotId
+	}
+	return 0
+}
+
+// The consultation rescheduling response, containing the inspector of the moved appointment,
+// who is kept the same if they are available in the new slot.
+type RescheduleConsultationAppointmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Inspector *AuthorityUser `protobuf:"bytes,1,opt,name=inspector,proto3" json:"inspector,omitempty"`
+}
+
+func (x *RescheduleConsultationAppointmentResponse) Reset() {
+	*x = RescheduleConsultationAppointmentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RescheduleConsultationAppointmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleConsultationAppointmentResponse) ProtoMessage() {}
+
+func (x *RescheduleConsultationAppointmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleConsultationAppointmentResponse.ProtoReflect.Descriptor instead.
+func (*RescheduleConsultationAppointmentResponse) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RescheduleConsultationAppointmentResponse) GetInspector() *AuthorityUser {
+	if x != nil {
+		return x.Inspector
+	}
+	return nil
+}
+
+// The consultation waitlist joining request. Only one range can be waited for per topic at a time.
+type JoinConsultationWaitlistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthorityId int64                  `protobuf:"varint,1,opt,name=authority_id,json=authorityId,proto3" json:"authority_id,omitempty"`
+	TopicId     int64                  `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	FromDate    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	ToDate      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+}
+
+func (x *JoinConsultationWaitlistRequest) Reset() {
+	*x = JoinConsultationWaitlistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinConsultationWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinConsultationWaitlistRequest) ProtoMessage() {}
+
+func (x *JoinConsultationWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinConsultationWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*JoinConsultationWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *JoinConsultationWaitlistRequest) GetAuthorityId() int64 {
+	if x != nil {
+		return x.AuthorityId
+	}
+	return 0
+}
+
+func (x *JoinConsultationWaitlistRequest) GetTopicId() int64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *JoinConsultationWaitlistRequest) GetFromDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromDate
+	}
+	return nil
+}
+
+func (x *JoinConsultationWaitlistRequest) GetToDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ToDate
+	}
+	return nil
+}
+
+// The consultation waitlist leaving request.
+type LeaveConsultationWaitlistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId int64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+}
+
+func (x *LeaveConsultationWaitlistRequest) Reset() {
+	*x = LeaveConsultationWaitlistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveConsultationWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveConsultationWaitlistRequest) ProtoMessage() {}
+
+func (x *LeaveConsultationWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveConsultationWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*LeaveConsultationWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *LeaveConsultationWaitlistRequest) GetTopicId() int64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+// The consultation appointment listing response, containing all of the details about a single consultation appointment.
+type ListConsultationAppointmentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppointmentInfo []*ListConsultationAppointmentsResponse_AppointmentInfo `protobuf:"bytes,1,rep,name=appointment_info,json=appointmentInfo,proto3" json:"appointment_info,omitempty"`
+}
+
+func (x *ListConsultationAppointmentsResponse) Reset() {
+	*x = ListConsultationAppointmentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConsultationAppointmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConsultationAppointmentsResponse) ProtoMessage() {}
+
+func (x *ListConsultationAppointmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConsultationAppointmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListConsultationAppointmentsResponse) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListConsultationAppointmentsResponse) GetAppointmentInfo() []*ListConsultationAppointmentsResponse_AppointmentInfo {
+	if x != nil {
+		return x.AppointmentInfo
+	}
+	return nil
+}
+
+// The consultation attachment download request.
+type GetConsultationAttachmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetConsultationAttachmentRequest) Reset() {
+	*x = GetConsultationAttachmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConsultationAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsultationAttachmentRequest) ProtoMessage() {}
+
+func (x *GetConsultationAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsultationAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*GetConsultationAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetConsultationAttachmentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// The consultation attachment download response, containing the contents of the file.
+type GetConsultationAttachmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content     []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *GetConsultationAttachmentResponse) Reset() {
+	*x = GetConsultationAttachmentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConsultationAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsultationAttachmentResponse) ProtoMessage() {}
+
+func (x *GetConsultationAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsultationAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*GetConsultationAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetConsultationAttachmentResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetConsultationAttachmentResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetConsultationAttachmentResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type ListSessionsResponse_SessionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Device     string                 `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	IpAddress  string                 `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastSeenAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	Current    bool                   `protobuf:"varint,6,opt,name=current,proto3" json:"current,omitempty"`
+}
+
+func (x *ListSessionsResponse_SessionInfo) Reset() {
+	*x = ListSessionsResponse_SessionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (*ListConsultationAppointmentsResponse) ProtoMessage() {}
+func (x *ListSessionsResponse_SessionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func (x *ListConsultationAppointmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_v1_app_proto_msgTypes[18]
+func (*ListSessionsResponse_SessionInfo) ProtoMessage() {}
+
+func (x *ListSessionsResponse_SessionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1245,18 +2915,53 @@ func (x *ListConsultationAppointmentsResponse) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListConsultationAppointmentsResponse.ProtoReflect.Descriptor instead.
-func (*ListConsultationAppointmentsResponse) Descriptor() ([]byte, []int) {
-	return file_api_app_v1_app_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ListSessionsResponse_SessionInfo.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse_SessionInfo) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{10, 0}
 }
 
-func (x *ListConsultationAppointmentsResponse) GetAppointmentInfo() []*ListConsultationAppointmentsResponse_AppointmentInfo {
+func (x *ListSessionsResponse_SessionInfo) GetId() string {
 	if x != nil {
-		return x.AppointmentInfo
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListSessionsResponse_SessionInfo) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *ListSessionsResponse_SessionInfo) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *ListSessionsResponse_SessionInfo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ListSessionsResponse_SessionInfo) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
 	}
 	return nil
 }
 
+func (x *ListSessionsResponse_SessionInfo) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
 type ListConsultationTopicsResponse_AuthorityTopic struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1269,7 +2974,7 @@ type ListConsultationTopicsResponse_AuthorityTopic struct {
 func (x *ListConsultationTopicsResponse_AuthorityTopic) Reset() {
 	*x = ListConsultationTopicsResponse_AuthorityTopic{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_v1_app_proto_msgTypes[19]
+		mi := &file_api_app_v1_app_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1282,7 +2987,7 @@ func (x *ListConsultationTopicsResponse_AuthorityTopic) String() string {
 func (*ListConsultationTopicsResponse_AuthorityTopic) ProtoMessage() {}
 
 func (x *ListConsultationTopicsResponse_AuthorityTopic) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_v1_app_proto_msgTypes[19]
+	mi := &file_api_app_v1_app_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1295,7 +3000,7 @@ func (x *ListConsultationTopicsResponse_AuthorityTopic) ProtoReflect() protorefl
 
 // Deprecated: Use ListConsultationTopicsResponse_AuthorityTopic.ProtoReflect.Descriptor instead.
 func (*ListConsultationTopicsResponse_AuthorityTopic) Descriptor() ([]byte, []int) {
-	return file_api_app_v1_app_proto_rawDescGZIP(), []int{10, 0}
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{27, 0}
 }
 
 func (x *ListConsultationTopicsResponse_AuthorityTopic) GetTopicId() int64 {
@@ -1325,7 +3030,7 @@ type ListConsultationTopicsResponse_AuthorityTopics struct {
 func (x *ListConsultationTopicsResponse_AuthorityTopics) Reset() {
 	*x = ListConsultationTopicsResponse_AuthorityTopics{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_v1_app_proto_msgTypes[20]
+		mi := &file_api_app_v1_app_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1338,7 +3043,7 @@ func (x *ListConsultationTopicsResponse_AuthorityTopics) String() string {
 func (*ListConsultationTopicsResponse_AuthorityTopics) ProtoMessage() {}
 
 func (x *ListConsultationTopicsResponse_AuthorityTopics) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_v1_app_proto_msgTypes[20]
+	mi := &file_api_app_v1_app_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1351,7 +3056,7 @@ func (x *ListConsultationTopicsResponse_AuthorityTopics) ProtoReflect() protoref
 
 // Deprecated: Use ListConsultationTopicsResponse_AuthorityTopics.ProtoReflect.Descriptor instead.
 func (*ListConsultationTopicsResponse_AuthorityTopics) Descriptor() ([]byte, []int) {
-	return file_api_app_v1_app_proto_rawDescGZIP(), []int{10, 1}
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{27, 1}
 }
 
 func (x *ListConsultationTopicsResponse_AuthorityTopics) GetAuthorityId() int64 {
@@ -1388,7 +3093,7 @@ type ListAvailableConsultationSlotsResponse_ConsultationSlot struct {
 func (x *ListAvailableConsultationSlotsResponse_ConsultationSlot) Reset() {
 	*x = ListAvailableConsultationSlotsResponse_ConsultationSlot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_v1_app_proto_msgTypes[21]
+		mi := &file_api_app_v1_app_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1401,7 +3106,7 @@ func (x *ListAvailableConsultationSlotsResponse_ConsultationSlot) String() strin
 func (*ListAvailableConsultationSlotsResponse_ConsultationSlot) ProtoMessage() {}
 
 func (x *ListAvailableConsultationSlotsResponse_ConsultationSlot) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_v1_app_proto_msgTypes[21]
+	mi := &file_api_app_v1_app_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1414,7 +3119,7 @@ func (x *ListAvailableConsultationSlotsResponse_ConsultationSlot) ProtoReflect()
 
 // Deprecated: Use ListAvailableConsultationSlotsResponse_ConsultationSlot.ProtoReflect.Descriptor instead.
 func (*ListAvailableConsultationSlotsResponse_ConsultationSlot) Descriptor() ([]byte, []int) {
-	return file_api_app_v1_app_proto_rawDescGZIP(), []int{14, 0}
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{31, 0}
 }
 
 func (x *ListAvailableConsultationSlotsResponse_ConsultationSlot) GetId() int64 {
@@ -1443,19 +3148,23 @@ type ListConsultationAppointmentsResponse_AppointmentInfo struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Topic         string                 `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
-	FromTime      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
-	ToTime        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
-	BusinessUser  *BusinessUser          `protobuf:"bytes,5,opt,name=business_user,json=businessUser,proto3" json:"business_user,omitempty"`
-	AuthorityUser *AuthorityUser         `protobuf:"bytes,6,opt,name=authority_user,json=authorityUser,proto3" json:"authority_user,omitempty"`
-	Canceled      bool                   `protobuf:"varint,7,opt,name=canceled,proto3" json:"canceled,omitempty"`
+	Id            string                                             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Topic         string                                             `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	FromTime      *timestamppb.Timestamp                             `protobuf:"bytes,3,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime        *timestamppb.Timestamp                             `protobuf:"bytes,4,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	BusinessUser  *BusinessUser                                      `protobuf:"bytes,5,opt,name=business_user,json=businessUser,proto3" json:"business_user,omitempty"`
+	AuthorityUser *AuthorityUser                                     `protobuf:"bytes,6,opt,name=authority_user,json=authorityUser,proto3" json:"authority_user,omitempty"`
+	Canceled      bool                                               `protobuf:"varint,7,opt,name=canceled,proto3" json:"canceled,omitempty"`
+	CanceledBy    ListConsultationAppointmentsResponse_Canceler      `protobuf:"varint,8,opt,name=canceled_by,json=canceledBy,proto3,enum=ldt_hack.app.v1.ListConsultationAppointmentsResponse_Canceler" json:"canceled_by,omitempty"`
+	CancelReason  string                                             `protobuf:"bytes,9,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"`
+	Question      string                                             `protobuf:"bytes,10,opt,name=question,proto3" json:"question,omitempty"`
+	Attachments   []*ListConsultationAppointmentsResponse_Attachment `protobuf:"bytes,11,rep,name=attachments,proto3" json:"attachments,omitempty"`
 }
 
 func (x *ListConsultationAppointmentsResponse_AppointmentInfo) Reset() {
 	*x = ListConsultationAppointmentsResponse_AppointmentInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_v1_app_proto_msgTypes[22]
+		mi := &file_api_app_v1_app_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1468,7 +3177,7 @@ func (x *ListConsultationAppointmentsResponse_AppointmentInfo) String() string {
 func (*ListConsultationAppointmentsResponse_AppointmentInfo) ProtoMessage() {}
 
 func (x *ListConsultationAppointmentsResponse_AppointmentInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_v1_app_proto_msgTypes[22]
+	mi := &file_api_app_v1_app_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1481,7 +3190,7 @@ func (x *ListConsultationAppointmentsResponse_AppointmentInfo) ProtoReflect() pr
 
 // Deprecated: Use ListConsultationAppointmentsResponse_AppointmentInfo.ProtoReflect.Descriptor instead.
 func (*ListConsultationAppointmentsResponse_AppointmentInfo) Descriptor() ([]byte, []int) {
-	return file_api_app_v1_app_proto_rawDescGZIP(), []int{18, 0}
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{42, 0}
 }
 
 func (x *ListConsultationAppointmentsResponse_AppointmentInfo) GetId() string {
@@ -1533,16 +3242,142 @@ func (x *ListConsultationAppointmentsResponse_AppointmentInfo) GetCanceled() boo
 	return false
 }
 
+func (x *ListConsultationAppointmentsResponse_AppointmentInfo) GetCanceledBy() ListConsultationAppointmentsResponse_Canceler {
+	if x != nil {
+		return x.CanceledBy
+	}
+	return ListConsultationAppointmentsResponse_CANCELER_NONE
+}
+
+func (x *ListConsultationAppointmentsResponse_AppointmentInfo) GetCancelReason() string {
+	if x != nil {
+		return x.CancelReason
+	}
+	return ""
+}
+
+func (x *ListConsultationAppointmentsResponse_AppointmentInfo) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *ListConsultationAppointmentsResponse_AppointmentInfo) GetAttachments() []*ListConsultationAppointmentsResponse_Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+// Attachment contains the details of a file attached to the appointment, which can be downloaded via GetConsultationAttachment.
+type ListConsultationAppointmentsResponse_Attachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size        int64  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *ListConsultationAppointmentsResponse_Attachment) Reset() {
+	*x = ListConsultationAppointmentsResponse_Attachment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_v1_app_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConsultationAppointmentsResponse_Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConsultationAppointmentsResponse_Attachment) ProtoMessage() {}
+
+func (x *ListConsultationAppointmentsResponse_Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_v1_app_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConsultationAppointmentsResponse_Attachment.ProtoReflect.Descriptor instead.
+func (*ListConsultationAppointmentsResponse_Attachment) Descriptor() ([]byte, []int) {
+	return file_api_app_v1_app_proto_rawDescGZIP(), []int{42, 1}
+}
+
+func (x *ListConsultationAppointmentsResponse_Attachment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ListConsultationAppointmentsResponse_Attachment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListConsultationAppointmentsResponse_Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ListConsultationAppointmentsResponse_Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+var file_api_app_v1_app_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*AuthOptions)(nil),
+		Field:         50000,
+		Name:          "ldt_hack.app.v1.auth",
+		Tag:           "bytes,50000,opt,name=auth",
+		Filename:      "api/app/v1/app.proto",
+	},
+}
+
+// Extension fields to descriptorpb.MethodOptions.
+var (
+	// optional ldt_hack.app.v1.AuthOptions auth = 50000;
+	E_Auth = &file_api_app_v1_app_proto_extTypes[0]
+)
+
 var File_api_app_v1_app_proto protoreflect.FileDescriptor
 
 var file_api_app_v1_app_proto_rawDesc = []byte{
 	0x0a, 0x14, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b,
-	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa4, 0x02, 0x0a, 0x0c, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65,
+	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6c, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x23,
+	0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa4, 0x02, 0x0a, 0x0c, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65,
 	0x73, 0x73, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f,
 	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73,
 	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x74, 0x72, 0x6f, 0x6e, 0x79,
@@ -1568,117 +3403,226 @@ var file_api_app_v1_app_proto_rawDesc = []byte{
 	0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x75, 0x74,
 	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0x24, 0x0a, 0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x80, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x31, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e,
-	0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x4e, 0x0a, 0x19, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e,
-	0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0xe4, 0x01, 0x0a, 0x14, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x54, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x73,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68,
-	0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0b, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x44, 0x0a, 0x0b, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x45, 0x53,
-	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x42, 0x55, 0x53, 0x49, 0x4e, 0x45,
-	0x53, 0x53, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
-	0x55, 0x53, 0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x10, 0x01,
-	0x22, 0x9d, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x62,
-	0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x22, 0xcc, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x46, 0x0a, 0x0f, 0x74, 0x77, 0x6f, 0x5f, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1e, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x65, 0x70,
+	0x52, 0x0d, 0x74, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x65, 0x70, 0x22,
+	0x80, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65,
+	0x73, 0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x31, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
 	0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e,
-	0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08,
-	0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x64,
-	0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x09, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x42, 0x06, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x22, 0x35, 0x0a, 0x19, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x42, 0x6f, 0x74, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x48, 0x0a, 0x1a, 0x53, 0x65, 0x6e, 0x64, 0x43,
-	0x68, 0x61, 0x74, 0x42, 0x6f, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0xb0, 0x01, 0x0a, 0x12, 0x52, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x74, 0x42, 0x6f,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x42, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69,
-	0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68,
-	0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x43,
-	0x68, 0x61, 0x74, 0x42, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x61,
-	0x74, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x46, 0x0a, 0x06,
-	0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47,
-	0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x41,
-	0x54, 0x49, 0x4e, 0x47, 0x5f, 0x4e, 0x45, 0x47, 0x41, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12,
-	0x13, 0x0a, 0x0f, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49,
-	0x56, 0x45, 0x10, 0x02, 0x22, 0x8e, 0x03, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e,
-	0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x3f, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x54, 0x6f, 0x70, 0x69,
-	0x63, 0x73, 0x52, 0x0f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x54, 0x6f, 0x70,
-	0x69, 0x63, 0x73, 0x1a, 0x4a, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
-	0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64,
-	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x1a,
-	0xb3, 0x01, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x54, 0x6f, 0x70,
-	0x69, 0x63, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x56, 0x0a,
-	0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e,
+	0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x22, 0x4e, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x73, 0x69,
+	0x6e, 0x65, 0x73, 0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x31, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
 	0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x06, 0x74,
-	0x6f, 0x70, 0x69, 0x63, 0x73, 0x22, 0xb8, 0x01, 0x0a, 0x25, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x76,
-	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
-	0x49, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x22, 0xe4, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x54, 0x0a, 0x0c, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x31, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x22, 0x44, 0x0a, 0x0b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x42, 0x55, 0x53, 0x49, 0x4e, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x1a, 0x0a,
+	0x16, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x41, 0x55,
+	0x54, 0x48, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x10, 0x01, 0x22, 0x3c, 0x0a, 0x15, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5f, 0x0a, 0x1a, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55,
+	0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x48, 0x0a, 0x1c, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x22, 0xcf, 0x02, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x08, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e,
+	0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xe7, 0x01, 0x0a, 0x0b, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x74,
-	0x6f, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65,
-	0x22, 0x6d, 0x0a, 0x26, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
-	0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x61, 0x76,
-	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x65, 0x73, 0x22,
-	0x7a, 0x0a, 0x25, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
-	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3c, 0x0a, 0x0c,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x22, 0x26, 0x0a, 0x14, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x89, 0x01, 0x0a,
+	0x1b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x54, 0x0a, 0x0c,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x31, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x4f, 0x0a, 0x1b, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x28, 0x0a, 0x12, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x22, 0x65, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e,
+	0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x4d, 0x0a, 0x12, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xf2, 0x01, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63,
+	0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73,
+	0x73, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73,
+	0x73, 0x12, 0x3e, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e,
+	0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x77, 0x6f, 0x5f,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x74, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x5b,
+	0x0a, 0x16, 0x53, 0x65, 0x74, 0x75, 0x70, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x55, 0x72, 0x69, 0x22, 0x2c, 0x0a, 0x16, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x49, 0x0a, 0x17, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x22, 0x34, 0x0a, 0x1e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3f, 0x0a, 0x16, 0x54, 0x77,
+	0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x2c, 0x0a, 0x16, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x35, 0x0a, 0x19, 0x53, 0x65, 0x6e,
+	0x64, 0x43, 0x68, 0x61, 0x74, 0x42, 0x6f, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x48, 0x0a, 0x1a, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x42, 0x6f, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0xb0, 0x01, 0x0a, 0x12, 0x52,
+	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x74, 0x42, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x42, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2a, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x74, 0x42, 0x6f, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x46, 0x0a, 0x06, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x12, 0x0a, 0x0e, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x4e, 0x45,
+	0x47, 0x41, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x41, 0x54, 0x49,
+	0x4e, 0x47, 0x5f, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x22, 0x8e, 0x03,
+	0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x6a, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x6c, 0x64, 0x74,
+	0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x0f, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x1a, 0x4a, 0x0a, 0x0e,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x19,
+	0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0xb3, 0x01, 0x0a, 0x0f, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12,
+	0x25, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x56, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63,
+	0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e,
+	0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x22, 0xd3,
+	0x01, 0x0a, 0x25, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65,
 	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68,
 	0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
-	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0xb4, 0x02, 0x0a, 0x26,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x49, 0x64, 0x22, 0x6d, 0x0a, 0x26, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43,
+	0x0a, 0x0f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61,
+	0x74, 0x65, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x25, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x64,
+	0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x22, 0xb4, 0x02, 0x0a, 0x26,
 	0x4c, 0x69, 0x73, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e,
 	0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c,
@@ -1698,111 +3642,355 @@ var file_api_app_v1_app_proto_rawDesc = []byte{
 	0x07, 0x74, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x74, 0x6f, 0x54, 0x69,
-	0x6d, 0x65, 0x22, 0x5a, 0x0a, 0x24, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73,
-	0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x22, 0x65,
-	0x0a, 0x25, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x70, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x64, 0x74,
-	0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x70,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x36, 0x0a, 0x24, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43,
+	0x6d, 0x65, 0x22, 0x51, 0x0a, 0x1b, 0x48, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x73, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x49, 0x64, 0x22, 0x78, 0x0a, 0x1c, 0x48, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x6e,
+	0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x6c, 0x64, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22,
+	0xe6, 0x01, 0x0a, 0x24, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x68, 0x6f, 0x6c, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6c,
+	0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0b, 0x61, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x6f, 0x0a, 0x1c, 0x43, 0x6f, 0x6e, 0x73,
+	0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x65, 0x0a, 0x25, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b,
+	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x22, 0x4e, 0x0a, 0x24, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0x5a, 0x0a, 0x28, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f,
+	0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0b,
+	0x6e, 0x65, 0x77, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x53, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x22, 0x69, 0x0a, 0x29,
+	0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x69, 0x6e, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c,
+	0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x09, 0x69, 0x6e,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0xcd, 0x01, 0x0a, 0x1f, 0x4a, 0x6f, 0x69, 0x6e,
+	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x61, 0x69, 0x74,
+	0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x66, 0x72, 0x6f,
+	0x6d, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x22, 0x3d, 0x0a, 0x20, 0x4c, 0x65, 0x61, 0x76, 0x65,
+	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x61, 0x69, 0x74,
+	0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x22, 0xa4, 0x07, 0x0a, 0x24, 0x4c, 0x69, 0x73, 0x74, 0x43,
 	0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xe7, 0x03,
-	0x0a, 0x24, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x45, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0xcc, 0x02, 0x0a, 0x0f, 0x41, 0x70, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70,
-	0x69, 0x63, 0x12, 0x37, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x6e, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x70, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x6c, 0x64, 0x74, 0x5f,
+	0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0f, 0x61, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x1a, 0xd2, 0x04, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x37, 0x0a, 0x09, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x74,
-	0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x42, 0x0a, 0x0d, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x75, 0x73, 0x65,
-	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61,
-	0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65,
-	0x73, 0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0c, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c,
-	0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0d, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63,
-	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63,
-	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x2a, 0x37, 0x0a, 0x09, 0x50, 0x65, 0x72, 0x73, 0x6f,
+	0x70, 0x52, 0x06, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0d, 0x62, 0x75, 0x73,
+	0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x0c, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x55, 0x73, 0x65, 0x72, 0x12, 0x45, 0x0a,
+	0x0e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b,
+	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64,
+	0x12, 0x5f, 0x0a, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3e, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b,
+	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73,
+	0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x65, 0x72, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x62, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61,
+	0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x67, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22,
+	0x4c, 0x0a, 0x08, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x12, 0x11, 0x0a, 0x0d, 0x43,
+	0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x15,
+	0x0a, 0x11, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x52, 0x5f, 0x42, 0x55, 0x53, 0x49, 0x4e,
+	0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45,
+	0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x10, 0x02, 0x22, 0x32, 0x0a,
+	0x20, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x74, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2a, 0x37, 0x0a, 0x09, 0x50, 0x65, 0x72, 0x73, 0x6f,
 	0x6e, 0x53, 0x65, 0x78, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x45, 0x52, 0x53, 0x4f, 0x4e, 0x5f, 0x53,
 	0x45, 0x58, 0x5f, 0x4d, 0x41, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x45, 0x52,
 	0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x58, 0x5f, 0x46, 0x45, 0x4d, 0x41, 0x4c, 0x45, 0x10, 0x01,
-	0x32, 0xed, 0x0a, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x5f, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73,
-	0x73, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2a, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b,
-	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75,
-	0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x58, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65,
-	0x73, 0x73, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2a, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63,
-	0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42,
-	0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x44, 0x0a, 0x12, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x55, 0x73, 0x65, 0x72,
+	0x2a, 0x61, 0x0a, 0x0d, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x65,
+	0x70, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x57, 0x4f, 0x5f, 0x46, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x5f,
+	0x53, 0x54, 0x45, 0x50, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x54,
+	0x57, 0x4f, 0x5f, 0x46, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x53, 0x54, 0x45, 0x50, 0x5f, 0x56,
+	0x45, 0x52, 0x49, 0x46, 0x59, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x57, 0x4f, 0x5f, 0x46,
+	0x41, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x53, 0x54, 0x45, 0x50, 0x5f, 0x45, 0x4e, 0x52, 0x4f, 0x4c,
+	0x4c, 0x10, 0x02, 0x32, 0xe7, 0x24, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x67, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x73, 0x69,
+	0x6e, 0x65, 0x73, 0x73, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2a, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68,
+	0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e,
+	0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x79, 0x0a, 0x12, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x2a, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65,
+	0x73, 0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1f, 0x82, 0xb5, 0x18, 0x1b, 0x1a, 0x0f, 0x62, 0x75, 0x73,
+	0x69, 0x6e, 0x65, 0x73, 0x73, 0x3a, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x12, 0x08, 0x62, 0x75,
+	0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x65, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1f, 0x82, 0xb5,
+	0x18, 0x1b, 0x12, 0x08, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x1a, 0x0f, 0x62, 0x75,
+	0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x3a, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x12, 0x5d, 0x0a,
+	0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25,
+	0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b,
+	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x5f, 0x0a, 0x0e,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26,
+	0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63,
+	0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x55, 0x0a,
+	0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x14,
+	0x82, 0xb5, 0x18, 0x10, 0x1a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x12, 0x63, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x25, 0x2e, 0x6c,
+	0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xb5, 0x18, 0x10, 0x1a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x3a, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x12, 0x64, 0x0a, 0x0d, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x6c, 0x64, 0x74,
+	0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x14, 0x82, 0xb5, 0x18, 0x10, 0x1a,
+	0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x12,
+	0x61, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2b, 0x2e,
+	0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02,
+	0x08, 0x01, 0x12, 0x71, 0x0a, 0x14, 0x4c, 0x69, 0x6e, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x2b, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x14, 0x82, 0xb5, 0x18, 0x10, 0x1a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x12, 0x6d, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x2d,
+	0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x06, 0x82, 0xb5,
+	0x18, 0x02, 0x08, 0x01, 0x12, 0x64, 0x0a, 0x14, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x2c, 0x2e, 0x6c,
+	0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x64, 0x0a, 0x14, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x12, 0x2c, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01,
+	0x12, 0x66, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x26, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x14, 0x82, 0xb5, 0x18, 0x10, 0x1a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x3a, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x12, 0x60, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x23, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61,
+	0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x14, 0x82, 0xb5, 0x18, 0x10, 0x1a, 0x0e, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x3a, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x12, 0x5a, 0x0a, 0x12, 0x52, 0x65,
+	0x73, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x55, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x25, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68,
-	0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x51, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x27, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x12, 0x53, 0x65,
-	0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x42, 0x6f, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x2a, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x42, 0x6f, 0x74, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6c,
-	0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x42, 0x6f, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x52, 0x61, 0x74,
-	0x65, 0x43, 0x68, 0x61, 0x74, 0x42, 0x6f, 0x74, 0x12, 0x23, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68,
-	0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x43,
-	0x68, 0x61, 0x74, 0x42, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x61, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e,
-	0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12,
+	0x22, 0x14, 0x82, 0xb5, 0x18, 0x10, 0x1a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x12, 0x60, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x23, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b,
+	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x14, 0x82, 0xb5, 0x18, 0x10, 0x1a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x3a, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x12, 0x75, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x75,
+	0x70, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x27, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xb5, 0x18,
+	0x1e, 0x12, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x1a, 0x11, 0x74, 0x77,
+	0x6f, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x3a, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x12,
+	0x87, 0x01, 0x0a, 0x0f, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x27, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61,
+	0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6c,
+	0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x22, 0x82, 0xb5, 0x18, 0x1e, 0x1a, 0x11, 0x74, 0x77, 0x6f,
+	0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x3a, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x12, 0x09,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x88, 0x01, 0x0a, 0x10, 0x44, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x28,
+	0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x32, 0x82, 0xb5, 0x18, 0x2e, 0x12, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x1a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x1a, 0x11, 0x74, 0x77, 0x6f, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x3a, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x12, 0xa7, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73,
+	0x12, 0x2f, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x32, 0x82, 0xb5, 0x18, 0x2e,
+	0x12, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x1a, 0x0e, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x1a, 0x11, 0x74, 0x77, 0x6f,
+	0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x3a, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x12, 0x7d,
+	0x0a, 0x0f, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x27, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x64, 0x74,
+	0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x22, 0x82, 0xb5, 0x18, 0x1e, 0x12,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x1a, 0x11, 0x74, 0x77, 0x6f, 0x5f,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x3a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x67, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x12,
 	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2f, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61,
-	0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
-	0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x91, 0x01, 0x0a, 0x1e, 0x4c, 0x69, 0x73,
-	0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x36, 0x2e, 0x6c, 0x64,
-	0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75,
-	0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61,
-	0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
-	0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
-	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x91, 0x01, 0x0a,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x27, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61,
+	0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x14, 0x82, 0xb5, 0x18, 0x10, 0x1a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x12, 0x8a, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64, 0x43,
+	0x68, 0x61, 0x74, 0x42, 0x6f, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x2e,
+	0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x42, 0x6f, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6c, 0x64, 0x74, 0x5f,
+	0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64,
+	0x43, 0x68, 0x61, 0x74, 0x42, 0x6f, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xb5, 0x18, 0x17, 0x12, 0x08, 0x62, 0x75,
+	0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x1a, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x62, 0x6f, 0x74, 0x3a,
+	0x75, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x0b, 0x52, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x74, 0x42,
+	0x6f, 0x74, 0x12, 0x23, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x74, 0x42, 0x6f, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x1b, 0x82, 0xb5, 0x18, 0x17, 0x12, 0x08, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x1a,
+	0x0b, 0x63, 0x68, 0x61, 0x74, 0x62, 0x6f, 0x74, 0x3a, 0x75, 0x73, 0x65, 0x12, 0x85, 0x01, 0x0a,
+	0x16, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x2f, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x22, 0x82, 0xb5, 0x18, 0x1e, 0x12, 0x08, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73,
+	0x1a, 0x12, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a,
+	0x62, 0x6f, 0x6f, 0x6b, 0x12, 0xb5, 0x01, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x36, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61,
+	0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x37, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x43,
+	0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xb5, 0x18, 0x1e, 0x12, 0x08,
+	0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x1a, 0x12, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0xb5, 0x01, 0x0a,
 	0x1e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f,
 	0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12,
 	0x36, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76,
@@ -1812,31 +4000,104 @@ var file_api_app_v1_app_proto_rawDesc = []byte{
 	0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x76,
 	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x8e, 0x01, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75,
-	0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x35, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75,
-	0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6c, 0x64, 0x74, 0x5f,
-	0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x6e, 0x0a, 0x1d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75,
-	0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x35, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75,
-	0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x12, 0x6d, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74,
+	0x22, 0x22, 0x82, 0xb5, 0x18, 0x1e, 0x12, 0x08, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73,
+	0x1a, 0x12, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a,
+	0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x97, 0x01, 0x0a, 0x14, 0x48, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x6e,
+	0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x2c, 0x2e,
+	0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x48, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6c, 0x64,
+	0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f,
+	0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c,
+	0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xb5, 0x18, 0x1e,
+	0x12, 0x08, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x1a, 0x12, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0xb2,
+	0x01, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x35, 0x2e, 0x6c, 0x64, 0x74, 0x5f,
-	0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x35, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61,
+	0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x42, 0x15, 0x5a, 0x13, 0x6c, 0x64, 0x74, 0x2d, 0x68, 0x61, 0x63, 0x6b, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6e, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x22, 0x82, 0xb5, 0x18, 0x1e, 0x12, 0x08, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x1a,
+	0x12, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x62,
+	0x6f, 0x6f, 0x6b, 0x12, 0x8a, 0x01, 0x0a, 0x1d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f,
+	0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b,
+	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f,
+	0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x1a, 0x82, 0xb5, 0x18, 0x16, 0x1a, 0x14, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x12, 0xa8, 0x01, 0x0a, 0x26, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x2e, 0x6c, 0x64,
+	0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2f, 0x82, 0xb5, 0x18, 0x2b,
+	0x1a, 0x1e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0xbe, 0x01, 0x0a, 0x21,
+	0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x39, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f,
+	0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x6c,
+	0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xb5, 0x18, 0x1e, 0x12, 0x08,
+	0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x1a, 0x12, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x88, 0x01, 0x0a,
+	0x18, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x30, 0x2e, 0x6c, 0x64, 0x74, 0x5f,
+	0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x69, 0x6e,
+	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x61, 0x69, 0x74,
+	0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x22, 0x82, 0xb5, 0x18, 0x1e, 0x12, 0x08, 0x62, 0x75, 0x73, 0x69, 0x6e,
+	0x65, 0x73, 0x73, 0x1a, 0x12, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x3a, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x8a, 0x01, 0x0a, 0x19, 0x4c, 0x65, 0x61, 0x76,
+	0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x61, 0x69,
+	0x74, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x31, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b,
+	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e,
+	0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x22, 0x82, 0xb5, 0x18, 0x1e, 0x12, 0x08, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73,
+	0x1a, 0x12, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a,
+	0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x87, 0x01, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e,
+	0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x35, 0x2e,
+	0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xb5, 0x18, 0x14, 0x1a, 0x12, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x76, 0x69, 0x65, 0x77, 0x12, 0x9c,
+	0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x2e, 0x6c,
+	0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x32, 0x2e, 0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xb5, 0x18, 0x14, 0x1a, 0x12, 0x63, 0x6f, 0x6e, 0x73, 0x75,
+	0x6c, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x76, 0x69, 0x65, 0x77, 0x3a, 0x52, 0x0a,
+	0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd0, 0x86, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6c, 0x64, 0x74, 0x5f, 0x68, 0x61, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x61, 0x75, 0x74,
+	0x68, 0x42, 0x15, 0x5a, 0x13, 0x6c, 0x64, 0x74, 0x2d, 0x68, 0x61, 0x63, 0x6b, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1851,93 +4112,187 @@ func file_api_app_v1_app_proto_rawDescGZIP() []byte {
 	return file_api_app_v1_app_proto_rawDescData
 }
 
-var file_api_app_v1_app_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_api_app_v1_app_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_api_app_v1_app_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_api_app_v1_app_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
 var file_api_app_v1_app_proto_goTypes = []interface{}{
 	(PersonSex)(0),                                                  // 0: ldt_hack.app.v1.PersonSex
-	(CreateSessionRequest_SessionUser)(0),                           // 1: ldt_hack.app.v1.CreateSessionRequest.SessionUser
-	(RateChatBotRequest_Rating)(0),                                  // 2: ldt_hack.app.v1.RateChatBotRequest.Rating
-	(*BusinessUser)(nil),                                            // 3: ldt_hack.app.v1.BusinessUser
-	(*AuthorityUser)(nil),                                           // 4: ldt_hack.app.v1.AuthorityUser
-	(*SessionToken)(nil),                                            // 5: ldt_hack.app.v1.SessionToken
-	(*CreateBusinessUserRequest)(nil),                               // 6: ldt_hack.app.v1.CreateBusinessUserRequest
-	(*UpdateBusinessUserRequest)(nil),                               // 7: ldt_hack.app.v1.UpdateBusinessUserRequest
-	(*CreateSessionRequest)(nil),                                    // 8: ldt_hack.app.v1.CreateSessionRequest
-	(*GetSessionUserResponse)(nil),                                  // 9: ldt_hack.app.v1.GetSessionUserResponse
-	(*SendChatBotMessageRequest)(nil),                               // 10: ldt_hack.app.v1.SendChatBotMessageRequest
-	(*SendChatBotMessageResponse)(nil),                              // 11: ldt_hack.app.v1.SendChatBotMessageResponse
-	(*RateChatBotRequest)(nil),                                      // 12: ldt_hack.app.v1.RateChatBotRequest
-	(*ListConsultationTopicsResponse)(nil),                          // 13: ldt_hack.app.v1.ListConsultationTopicsResponse
-	(*ListAvailableConsultationDatesRequest)(nil),                   // 14: ldt_hack.app.v1.ListAvailableConsultationDatesRequest
-	(*ListAvailableConsultationDatesResponse)(nil),                  // 15: ldt_hack.app.v1.ListAvailableConsultationDatesResponse
-	(*ListAvailableConsultationSlotsRequest)(nil),                   // 16: ldt_hack.app.v1.ListAvailableConsultationSlotsRequest
-	(*ListAvailableConsultationSlotsResponse)(nil),                  // 17: ldt_hack.app.v1.ListAvailableConsultationSlotsResponse
-	(*CreateConsultationAppointmentRequest)(nil),                    // 18: ldt_hack.app.v1.CreateConsultationAppointmentRequest
-	(*CreateConsultationAppointmentResponse)(nil),                   // 19: ldt_hack.app.v1.CreateConsultationAppointmentResponse
-	(*CancelConsultationAppointmentRequest)(nil),                    // 20: ldt_hack.app.v1.CancelConsultationAppointmentRequest
-	(*ListConsultationAppointmentsResponse)(nil),                    // 21: ldt_hack.app.v1.ListConsultationAppointmentsResponse
-	(*ListConsultationTopicsResponse_AuthorityTopic)(nil),           // 22: ldt_hack.app.v1.ListConsultationTopicsResponse.AuthorityTopic
-	(*ListConsultationTopicsResponse_AuthorityTopics)(nil),          // 23: ldt_hack.app.v1.ListConsultationTopicsResponse.AuthorityTopics
-	(*ListAvailableConsultationSlotsResponse_ConsultationSlot)(nil), // 24: ldt_hack.app.v1.ListAvailableConsultationSlotsResponse.ConsultationSlot
-	(*ListConsultationAppointmentsResponse_AppointmentInfo)(nil),    // 25: ldt_hack.app.v1.ListConsultationAppointmentsResponse.AppointmentInfo
-	(*timestamppb.Timestamp)(nil),                                   // 26: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                                           // 27: google.protobuf.Empty
+	(TwoFactorStep)(0),                                              // 1: ldt_hack.app.v1.TwoFactorStep
+	(CreateSessionRequest_SessionUser)(0),                           // 2: ldt_hack.app.v1.CreateSessionRequest.SessionUser
+	(RateChatBotRequest_Rating)(0),                                  // 3: ldt_hack.app.v1.RateChatBotRequest.Rating
+	(ListConsultationAppointmentsResponse_Canceler)(0),              // 4: ldt_hack.app.v1.ListConsultationAppointmentsResponse.Canceler
+	(*AuthOptions)(nil),                                             // 5: ldt_hack.app.v1.AuthOptions
+	(*BusinessUser)(nil),                                            // 6: ldt_hack.app.v1.BusinessUser
+	(*AuthorityUser)(nil),                                           // 7: ldt_hack.app.v1.AuthorityUser
+	(*SessionToken)(nil),                                            // 8: ldt_hack.app.v1.SessionToken
+	(*CreateBusinessUserRequest)(nil),                               // 9: ldt_hack.app.v1.CreateBusinessUserRequest
+	(*UpdateBusinessUserRequest)(nil),                               // 10: ldt_hack.app.v1.UpdateBusinessUserRequest
+	(*CreateSessionRequest)(nil),                                    // 11: ldt_hack.app.v1.CreateSessionRequest
+	(*RefreshSessionRequest)(nil),                                   // 12: ldt_hack.app.v1.RefreshSessionRequest
+	(*StartExternalLoginResponse)(nil),                              // 13: ldt_hack.app.v1.StartExternalLoginResponse
+	(*CompleteExternalLoginRequest)(nil),                            // 14: ldt_hack.app.v1.CompleteExternalLoginRequest
+	(*ListSessionsResponse)(nil),                                    // 15: ldt_hack.app.v1.ListSessionsResponse
+	(*RevokeSessionRequest)(nil),                                    // 16: ldt_hack.app.v1.RevokeSessionRequest
+	(*RequestPasswordResetRequest)(nil),                             // 17: ldt_hack.app.v1.RequestPasswordResetRequest
+	(*ConfirmPasswordResetRequest)(nil),                             // 18: ldt_hack.app.v1.ConfirmPasswordResetRequest
+	(*VerifyEmailRequest)(nil),                                      // 19: ldt_hack.app.v1.VerifyEmailRequest
+	(*ChangePasswordRequest)(nil),                                   // 20: ldt_hack.app.v1.ChangePasswordRequest
+	(*ChangeEmailRequest)(nil),                                      // 21: ldt_hack.app.v1.ChangeEmailRequest
+	(*GetSessionUserResponse)(nil),                                  // 22: ldt_hack.app.v1.GetSessionUserResponse
+	(*SetupTwoFactorResponse)(nil),                                  // 23: ldt_hack.app.v1.SetupTwoFactorResponse
+	(*EnableTwoFactorRequest)(nil),                                  // 24: ldt_hack.app.v1.EnableTwoFactorRequest
+	(*DisableTwoFactorRequest)(nil),                                 // 25: ldt_hack.app.v1.DisableTwoFactorRequest
+	(*RegenerateRecoveryCodesRequest)(nil),                          // 26: ldt_hack.app.v1.RegenerateRecoveryCodesRequest
+	(*TwoFactorRecoveryCodes)(nil),                                  // 27: ldt_hack.app.v1.TwoFactorRecoveryCodes
+	(*VerifyTwoFactorRequest)(nil),                                  // 28: ldt_hack.app.v1.VerifyTwoFactorRequest
+	(*SendChatBotMessageRequest)(nil),                               // 29: ldt_hack.app.v1.SendChatBotMessageRequest
+	(*SendChatBotMessageResponse)(nil),                              // 30: ldt_hack.app.v1.SendChatBotMessageResponse
+	(*RateChatBotRequest)(nil),                                      // 31: ldt_hack.app.v1.RateChatBotRequest
+	(*ListConsultationTopicsResponse)(nil),                          // 32: ldt_hack.app.v1.ListConsultationTopicsResponse
+	(*ListAvailableConsultationDatesRequest)(nil),                   // 33: ldt_hack.app.v1.ListAvailableConsultationDatesRequest
+	(*ListAvailableConsultationDatesResponse)(nil),                  // 34: ldt_hack.app.v1.ListAvailableConsultationDatesResponse
+	(*ListAvailableConsultationSlotsRequest)(nil),                   // 35: ldt_hack.app.v1.ListAvailableConsultationSlotsRequest
+	(*ListAvailableConsultationSlotsResponse)(nil),                  // 36: ldt_hack.app.v1.ListAvailableConsultationSlotsResponse
+	(*HoldConsultationSlotRequest)(nil),                             // 37: ldt_hack.app.v1.HoldConsultationSlotRequest
+	(*HoldConsultationSlotResponse)(nil),                            // 38: ldt_hack.app.v1.HoldConsultationSlotResponse
+	(*CreateConsultationAppointmentRequest)(nil),                    // 39: ldt_hack.app.v1.CreateConsultationAppointmentRequest
+	(*ConsultationAttachmentUpload)(nil),                            // 40: ldt_hack.app.v1.ConsultationAttachmentUpload
+	(*CreateConsultationAppointmentResponse)(nil),                   // 41: ldt_hack.app.v1.CreateConsultationAppointmentResponse
+	(*CancelConsultationAppointmentRequest)(nil),                    // 42: ldt_hack.app.v1.CancelConsultationAppointmentRequest
+	(*RescheduleConsultationAppointmentRequest)(nil),                // 43: ldt_hack.app.v1.RescheduleConsultationAppointmentRequest
+	(*RescheduleConsultationAppointmentResponse)(nil),               // 44: ldt_hack.app.v1.RescheduleConsultationAppointmentResponse
+	(*JoinConsultationWaitlistRequest)(nil),                         // 45: ldt_hack.app.v1.JoinConsultationWaitlistRequest
+	(*LeaveConsultationWaitlistRequest)(nil),                        // 46: ldt_hack.app.v1.LeaveConsultationWaitlistRequest
+	(*ListConsultationAppointmentsResponse)(nil),                    // 47: ldt_hack.app.v1.ListConsultationAppointmentsResponse
+	(*GetConsultationAttachmentRequest)(nil),                        // 48: ldt_hack.app.v1.GetConsultationAttachmentRequest
+	(*GetConsultationAttachmentResponse)(nil),                       // 49: ldt_hack.app.v1.GetConsultationAttachmentResponse
+	(*ListSessionsResponse_SessionInfo)(nil),                        // 50: ldt_hack.app.v1.ListSessionsResponse.SessionInfo
+	(*ListConsultationTopicsResponse_AuthorityTopic)(nil),           // 51: ldt_hack.app.v1.ListConsultationTopicsResponse.AuthorityTopic
+	(*ListConsultationTopicsResponse_AuthorityTopics)(nil),          // 52: ldt_hack.app.v1.ListConsultationTopicsResponse.AuthorityTopics
+	(*ListAvailableConsultationSlotsResponse_ConsultationSlot)(nil), // 53: ldt_hack.app.v1.ListAvailableConsultationSlotsResponse.ConsultationSlot
+	(*ListConsultationAppointmentsResponse_AppointmentInfo)(nil),    // 54: ldt_hack.app.v1.ListConsultationAppointmentsResponse.AppointmentInfo
+	(*ListConsultationAppointmentsResponse_Attachment)(nil),         // 55: ldt_hack.app.v1.ListConsultationAppointmentsResponse.Attachment
+	(*timestamppb.Timestamp)(nil),                                   // 56: google.protobuf.Timestamp
+	(*descriptorpb.MethodOptions)(nil),                              // 57: google.protobuf.MethodOptions
+	(*emptypb.Empty)(nil),                                           // 58: google.protobuf.Empty
 }
 var file_api_app_v1_app_proto_depIdxs = []int32{
 	0,  // 0: ldt_hack.app.v1.BusinessUser.sex:type_name -> ldt_hack.app.v1.PersonSex
-	26, // 1: ldt_hack.app.v1.BusinessUser.birth_date:type_name -> google.protobuf.Timestamp
-	3,  // 2: ldt_hack.app.v1.CreateBusinessUserRequest.user:type_name -> ldt_hack.app.v1.BusinessUser
-	3,  // 3: ldt_hack.app.v1.UpdateBusinessUserRequest.user:type_name -> ldt_hack.app.v1.BusinessUser
-	1,  // 4: ldt_hack.app.v1.CreateSessionRequest.session_user:type_name -> ldt_hack.app.v1.CreateSessionRequest.SessionUser
-	3,  // 5: ldt_hack.app.v1.GetSessionUserResponse.business:type_name -> ldt_hack.app.v1.BusinessUser
-	4,  // 6: ldt_hack.app.v1.GetSessionUserResponse.authority:type_name -> ldt_hack.app.v1.AuthorityUser
-	2,  // 7: ldt_hack.app.v1.RateChatBotRequest.rating:type_name -> ldt_hack.app.v1.RateChatBotRequest.Rating
-	23, // 8: ldt_hack.app.v1.ListConsultationTopicsResponse.authority_topics:type_name -> ldt_hack.app.v1.ListConsultationTopicsResponse.AuthorityTopics
-	26, // 9: ldt_hack.app.v1.ListAvailableConsultationDatesRequest.from_date:type_name -> google.protobuf.Timestamp
-	26, // 10: ldt_hack.app.v1.ListAvailableConsultationDatesRequest.to_date:type_name -> google.protobuf.Timestamp
-	26, // 11: ldt_hack.app.v1.ListAvailableConsultationDatesResponse.available_dates:type_name -> google.protobuf.Timestamp
-	26, // 12: ldt_hack.app.v1.ListAvailableConsultationSlotsRequest.date:type_name -> google.protobuf.Timestamp
-	24, // 13: ldt_hack.app.v1.ListAvailableConsultationSlotsResponse.consultation_slots:type_name -> ldt_hack.app.v1.ListAvailableConsultationSlotsResponse.ConsultationSlot
-	4,  // 14: ldt_hack.app.v1.CreateConsultationAppointmentResponse.inspector:type_name -> ldt_hack.app.v1.AuthorityUser
-	25, // 15: ldt_hack.app.v1.ListConsultationAppointmentsResponse.appointment_info:type_name -> ldt_hack.app.v1.ListConsultationAppointmentsResponse.AppointmentInfo
-	22, // 16: ldt_hack.app.v1.ListConsultationTopicsResponse.AuthorityTopics.topics:type_name -> ldt_hack.app.v1.ListConsultationTopicsResponse.AuthorityTopic
-	26, // 17: ldt_hack.app.v1.ListAvailableConsultationSlotsResponse.ConsultationSlot.from_time:type_name -> google.protobuf.Timestamp
-	26, // 18: ldt_hack.app.v1.ListAvailableConsultationSlotsResponse.ConsultationSlot.to_time:type_name -> google.protobuf.Timestamp
-	26, // 19: ldt_hack.app.v1.ListConsultationAppointmentsResponse.AppointmentInfo.from_time:type_name -> google.protobuf.Timestamp
-	26, // 20: ldt_hack.app.v1.ListConsultationAppointmentsResponse.AppointmentInfo.to_time:type_name -> google.protobuf.Timestamp
-	3,  // 21: ldt_hack.app.v1.ListConsultationAppointmentsResponse.AppointmentInfo.business_user:type_name -> ldt_hack.app.v1.BusinessUser
-	4,  // 22: ldt_hack.app.v1.ListConsultationAppointmentsResponse.AppointmentInfo.authority_user:type_name -> ldt_hack.app.v1.AuthorityUser
-	6,  // 23: ldt_hack.app.v1.AppService.CreateBusinessUser:input_type -> ldt_hack.app.v1.CreateBusinessUserRequest
-	7,  // 24: ldt_hack.app.v1.AppService.UpdateBusinessUser:input_type -> ldt_hack.app.v1.UpdateBusinessUserRequest
-	27, // 25: ldt_hack.app.v1.AppService.DeleteBusinessUser:input_type -> google.protobuf.Empty
-	8,  // 26: ldt_hack.app.v1.AppService.CreateSession:input_type -> ldt_hack.app.v1.CreateSessionRequest
-	27, // 27: ldt_hack.app.v1.AppService.GetSessionUser:input_type -> google.protobuf.Empty
-	10, // 28: ldt_hack.app.v1.AppService.SendChatBotMessage:input_type -> ldt_hack.app.v1.SendChatBotMessageRequest
-	12, // 29: ldt_hack.app.v1.AppService.RateChatBot:input_type -> ldt_hack.app.v1.RateChatBotRequest
-	27, // 30: ldt_hack.app.v1.AppService.ListConsultationTopics:input_type -> google.protobuf.Empty
-	14, // 31: ldt_hack.app.v1.AppService.ListAvailableConsultationDates:input_type -> ldt_hack.app.v1.ListAvailableConsultationDatesRequest
-	16, // 32: ldt_hack.app.v1.AppService.ListAvailableConsultationSlots:input_type -> ldt_hack.app.v1.ListAvailableConsultationSlotsRequest
-	18, // 33: ldt_hack.app.v1.AppService.CreateConsultationAppointment:input_type -> ldt_hack.app.v1.CreateConsultationAppointmentRequest
-	20, // 34: ldt_hack.app.v1.AppService.CancelConsultationAppointment:input_type -> ldt_hack.app.v1.CancelConsultationAppointmentRequest
-	27, // 35: ldt_hack.app.v1.AppService.ListConsultationAppointments:input_type -> google.protobuf.Empty
-	5,  // 36: ldt_hack.app.v1.AppService.CreateBusinessUser:output_type -> ldt_hack.app.v1.SessionToken
-	27, // 37: ldt_hack.app.v1.AppService.UpdateBusinessUser:output_type -> google.protobuf.Empty
-	27, // 38: ldt_hack.app.v1.AppService.DeleteBusinessUser:output_type -> google.protobuf.Empty
-	5,  // 39: ldt_hack.app.v1.AppService.CreateSession:output_type -> ldt_hack.app.v1.SessionToken
-	9,  // 40: ldt_hack.app.v1.AppService.GetSessionUser:output_type -> ldt_hack.app.v1.GetSessionUserResponse
-	11, // 41: ldt_hack.app.v1.AppService.SendChatBotMessage:output_type -> ldt_hack.app.v1.SendChatBotMessageResponse
-	27, // 42: ldt_hack.app.v1.AppService.RateChatBot:output_type -> google.protobuf.Empty
-	13, // 43: ldt_hack.app.v1.AppService.ListConsultationTopics:output_type -> ldt_hack.app.v1.ListConsultationTopicsResponse
-	15, // 44: ldt_hack.app.v1.AppService.ListAvailableConsultationDates:output_type -> ldt_hack.app.v1.ListAvailableConsultationDatesResponse
-	17, // 45: ldt_hack.app.v1.AppService.ListAvailableConsultationSlots:output_type -> ldt_hack.app.v1.ListAvailableConsultationSlotsResponse
-	19, // 46: ldt_hack.app.v1.AppService.CreateConsultationAppointment:output_type -> ldt_hack.app.v1.CreateConsultationAppointmentResponse
-	27, // 47: ldt_hack.app.v1.AppService.CancelConsultationAppointment:output_type -> google.protobuf.Empty
-	21, // 48: ldt_hack.app.v1.AppService.ListConsultationAppointments:output_type -> ldt_hack.app.v1.ListConsultationAppointmentsResponse
-	36, // [36:49] is the sub-list for method output_type
-	23, // [23:36] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	56, // 1: ldt_hack.app.v1.BusinessUser.birth_date:type_name -> google.protobuf.Timestamp
+	56, // 2: ldt_hack.app.v1.SessionToken.expires_at:type_name -> google.protobuf.Timestamp
+	1,  // 3: ldt_hack.app.v1.SessionToken.two_factor_step:type_name -> ldt_hack.app.v1.TwoFactorStep
+	6,  // 4: ldt_hack.app.v1.CreateBusinessUserRequest.user:type_name -> ldt_hack.app.v1.BusinessUser
+	6,  // 5: ldt_hack.app.v1.UpdateBusinessUserRequest.user:type_name -> ldt_hack.app.v1.BusinessUser
+	2,  // 6: ldt_hack.app.v1.CreateSessionRequest.session_user:type_name -> ldt_hack.app.v1.CreateSessionRequest.SessionUser
+	50, // 7: ldt_hack.app.v1.ListSessionsResponse.sessions:type_name -> ldt_hack.app.v1.ListSessionsResponse.SessionInfo
+	2,  // 8: ldt_hack.app.v1.RequestPasswordResetRequest.session_user:type_name -> ldt_hack.app.v1.CreateSessionRequest.SessionUser
+	6,  // 9: ldt_hack.app.v1.GetSessionUserResponse.business:type_name -> ldt_hack.app.v1.BusinessUser
+	7,  // 10: ldt_hack.app.v1.GetSessionUserResponse.authority:type_name -> ldt_hack.app.v1.AuthorityUser
+	3,  // 11: ldt_hack.app.v1.RateChatBotRequest.rating:type_name -> ldt_hack.app.v1.RateChatBotRequest.Rating
+	52, // 12: ldt_hack.app.v1.ListConsultationTopicsResponse.authority_topics:type_name -> ldt_hack.app.v1.ListConsultationTopicsResponse.AuthorityTopics
+	56, // 13: ldt_hack.app.v1.ListAvailableConsultationDatesRequest.from_date:type_name -> google.protobuf.Timestamp
+	56, // 14: ldt_hack.app.v1.ListAvailableConsultationDatesRequest.to_date:type_name -> google.protobuf.Timestamp
+	56, // 15: ldt_hack.app.v1.ListAvailableConsultationDatesResponse.available_dates:type_name -> google.protobuf.Timestamp
+	56, // 16: ldt_hack.app.v1.ListAvailableConsultationSlotsRequest.date:type_name -> google.protobuf.Timestamp
+	53, // 17: ldt_hack.app.v1.ListAvailableConsultationSlotsResponse.consultation_slots:type_name -> ldt_hack.app.v1.ListAvailableConsultationSlotsResponse.ConsultationSlot
+	56, // 18: ldt_hack.app.v1.HoldConsultationSlotResponse.expires_at:type_name -> google.protobuf.Timestamp
+	40, // 19: ldt_hack.app.v1.CreateConsultationAppointmentRequest.attachments:type_name -> ldt_hack.app.v1.ConsultationAttachmentUpload
+	7,  // 20: ldt_hack.app.v1.CreateConsultationAppointmentResponse.inspector:type_name -> ldt_hack.app.v1.AuthorityUser
+	7,  // 21: ldt_hack.app.v1.RescheduleConsultationAppointmentResponse.inspector:type_name -> ldt_hack.app.v1.AuthorityUser
+	56, // 22: ldt_hack.app.v1.JoinConsultationWaitlistRequest.from_date:type_name -> google.protobuf.Timestamp
+	56, // 23: ldt_hack.app.v1.JoinConsultationWaitlistRequest.to_date:type_name -> google.protobuf.Timestamp
+	54, // 24: ldt_hack.app.v1.ListConsultationAppointmentsResponse.appointment_info:type_name -> ldt_hack.app.v1.ListConsultationAppointmentsResponse.AppointmentInfo
+	56, // 25: ldt_hack.app.v1.ListSessionsResponse.SessionInfo.created_at:type_name -> google.protobuf.Timestamp
+	56, // 26: ldt_hack.app.v1.ListSessionsResponse.SessionInfo.last_seen_at:type_name -> google.protobuf.Timestamp
+	51, // 27: ldt_hack.app.v1.ListConsultationTopicsResponse.AuthorityTopics.topics:type_name -> ldt_hack.app.v1.ListConsultationTopicsResponse.AuthorityTopic
+	56, // 28: ldt_hack.app.v1.ListAvailableConsultationSlotsResponse.ConsultationSlot.from_time:type_name -> google.protobuf.Timestamp
+	56, // 29: ldt_hack.app.v1.ListAvailableConsultationSlotsResponse.ConsultationSlot.to_time:type_name -> google.protobuf.Timestamp
+	56, // 30: ldt_hack.app.v1.ListConsultationAppointmentsResponse.AppointmentInfo.from_time:type_name -> google.protobuf.Timestamp
+	56, // 31: ldt_hack.app.v1.ListConsultationAppointmentsResponse.AppointmentInfo.to_time:type_name -> google.protobuf.Timestamp
+	6,  // 32: ldt_hack.app.v1.ListConsultationAppointmentsResponse.AppointmentInfo.business_user:type_name -> ldt_hack.app.v1.BusinessUser
+	7,  // 33: ldt_hack.app.v1.ListConsultationAppointmentsResponse.AppointmentInfo.authority_user:type_name -> ldt_hack.app.v1.AuthorityUser
+	4,  // 34: ldt_hack.app.v1.ListConsultationAppointmentsResponse.AppointmentInfo.canceled_by:type_name -> ldt_hack.app.v1.ListConsultationAppointmentsResponse.Canceler
+	55, // 35: ldt_hack.app.v1.ListConsultationAppointmentsResponse.AppointmentInfo.attachments:type_name -> ldt_hack.app.v1.ListConsultationAppointmentsResponse.Attachment
+	57, // 36: ldt_hack.app.v1.auth:extendee -> google.protobuf.MethodOptions
+	5,  // 37: ldt_hack.app.v1.auth:type_name -> ldt_hack.app.v1.AuthOptions
+	9,  // 38: ldt_hack.app.v1.AppService.CreateBusinessUser:input_type -> ldt_hack.app.v1.CreateBusinessUserRequest
+	10, // 39: ldt_hack.app.v1.AppService.UpdateBusinessUser:input_type -> ldt_hack.app.v1.UpdateBusinessUserRequest
+	58, // 40: ldt_hack.app.v1.AppService.DeleteBusinessUser:input_type -> google.protobuf.Empty
+	11, // 41: ldt_hack.app.v1.AppService.CreateSession:input_type -> ldt_hack.app.v1.CreateSessionRequest
+	12, // 42: ldt_hack.app.v1.AppService.RefreshSession:input_type -> ldt_hack.app.v1.RefreshSessionRequest
+	58, // 43: ldt_hack.app.v1.AppService.DeleteSession:input_type -> google.protobuf.Empty
+	58, // 44: ldt_hack.app.v1.AppService.ListSessions:input_type -> google.protobuf.Empty
+	16, // 45: ldt_hack.app.v1.AppService.RevokeSession:input_type -> ldt_hack.app.v1.RevokeSessionRequest
+	58, // 46: ldt_hack.app.v1.AppService.StartExternalLogin:input_type -> google.protobuf.Empty
+	58, // 47: ldt_hack.app.v1.AppService.LinkExternalIdentity:input_type -> google.protobuf.Empty
+	14, // 48: ldt_hack.app.v1.AppService.CompleteExternalLogin:input_type -> ldt_hack.app.v1.CompleteExternalLoginRequest
+	17, // 49: ldt_hack.app.v1.AppService.RequestPasswordReset:input_type -> ldt_hack.app.v1.RequestPasswordResetRequest
+	18, // 50: ldt_hack.app.v1.AppService.ConfirmPasswordReset:input_type -> ldt_hack.app.v1.ConfirmPasswordResetRequest
+	20, // 51: ldt_hack.app.v1.AppService.ChangePassword:input_type -> ldt_hack.app.v1.ChangePasswordRequest
+	19, // 52: ldt_hack.app.v1.AppService.VerifyEmail:input_type -> ldt_hack.app.v1.VerifyEmailRequest
+	58, // 53: ldt_hack.app.v1.AppService.ResendVerification:input_type -> google.protobuf.Empty
+	21, // 54: ldt_hack.app.v1.AppService.ChangeEmail:input_type -> ldt_hack.app.v1.ChangeEmailRequest
+	58, // 55: ldt_hack.app.v1.AppService.SetupTwoFactor:input_type -> google.protobuf.Empty
+	24, // 56: ldt_hack.app.v1.AppService.EnableTwoFactor:input_type -> ldt_hack.app.v1.EnableTwoFactorRequest
+	25, // 57: ldt_hack.app.v1.AppService.DisableTwoFactor:input_type -> ldt_hack.app.v1.DisableTwoFactorRequest
+	26, // 58: ldt_hack.app.v1.AppService.RegenerateRecoveryCodes:input_type -> ldt_hack.app.v1.RegenerateRecoveryCodesRequest
+	28, // 59: ldt_hack.app.v1.AppService.VerifyTwoFactor:input_type -> ldt_hack.app.v1.VerifyTwoFactorRequest
+	58, // 60: ldt_hack.app.v1.AppService.GetSessionUser:input_type -> google.protobuf.Empty
+	29, // 61: ldt_hack.app.v1.AppService.SendChatBotMessage:input_type -> ldt_hack.app.v1.SendChatBotMessageRequest
+	31, // 62: ldt_hack.app.v1.AppService.RateChatBot:input_type -> ldt_hack.app.v1.RateChatBotRequest
+	58, // 63: ldt_hack.app.v1.AppService.ListConsultationTopics:input_type -> google.protobuf.Empty
+	33, // 64: ldt_hack.app.v1.AppService.ListAvailableConsultationDates:input_type -> ldt_hack.app.v1.ListAvailableConsultationDatesRequest
+	35, // 65: ldt_hack.app.v1.AppService.ListAvailableConsultationSlots:input_type -> ldt_hack.app.v1.ListAvailableConsultationSlotsRequest
+	37, // 66: ldt_hack.app.v1.AppService.HoldConsultationSlot:input_type -> ldt_hack.app.v1.HoldConsultationSlotRequest
+	39, // 67: ldt_hack.app.v1.AppService.CreateConsultationAppointment:input_type -> ldt_hack.app.v1.CreateConsultationAppointmentRequest
+	42, // 68: ldt_hack.app.v1.AppService.CancelConsultationAppointment:input_type -> ldt_hack.app.v1.CancelConsultationAppointmentRequest
+	42, // 69: ldt_hack.app.v1.AppService.CancelAuthorityConsultationAppointment:input_type -> ldt_hack.app.v1.CancelConsultationAppointmentRequest
+	43, // 70: ldt_hack.app.v1.AppService.RescheduleConsultationAppointment:input_type -> ldt_hack.app.v1.RescheduleConsultationAppointmentRequest
+	45, // 71: ldt_hack.app.v1.AppService.JoinConsultationWaitlist:input_type -> ldt_hack.app.v1.JoinConsultationWaitlistRequest
+	46, // 72: ldt_hack.app.v1.AppService.LeaveConsultationWaitlist:input_type -> ldt_hack.app.v1.LeaveConsultationWaitlistRequest
+	58, // 73: ldt_hack.app.v1.AppService.ListConsultationAppointments:input_type -> google.protobuf.Empty
+	48, // 74: ldt_hack.app.v1.AppService.GetConsultationAttachment:input_type -> ldt_hack.app.v1.GetConsultationAttachmentRequest
+	8,  // 75: ldt_hack.app.v1.AppService.CreateBusinessUser:output_type -> ldt_hack.app.v1.SessionToken
+	58, // 76: ldt_hack.app.v1.AppService.UpdateBusinessUser:output_type -> google.protobuf.Empty
+	58, // 77: ldt_hack.app.v1.AppService.DeleteBusinessUser:output_type -> google.protobuf.Empty
+	8,  // 78: ldt_hack.app.v1.AppService.CreateSession:output_type -> ldt_hack.app.v1.SessionToken
+	8,  // 79: ldt_hack.app.v1.AppService.RefreshSession:output_type -> ldt_hack.app.v1.SessionToken
+	58, // 80: ldt_hack.app.v1.AppService.DeleteSession:output_type -> google.protobuf.Empty
+	15, // 81: ldt_hack.app.v1.AppService.ListSessions:output_type -> ldt_hack.app.v1.ListSessionsResponse
+	58, // 82: ldt_hack.app.v1.AppService.RevokeSession:output_type -> google.protobuf.Empty
+	13, // 83: ldt_hack.app.v1.AppService.StartExternalLogin:output_type -> ldt_hack.app.v1.StartExternalLoginResponse
+	13, // 84: ldt_hack.app.v1.AppService.LinkExternalIdentity:output_type -> ldt_hack.app.v1.StartExternalLoginResponse
+	8,  // 85: ldt_hack.app.v1.AppService.CompleteExternalLogin:output_type -> ldt_hack.app.v1.SessionToken
+	58, // 86: ldt_hack.app.v1.AppService.RequestPasswordReset:output_type -> google.protobuf.Empty
+	58, // 87: ldt_hack.app.v1.AppService.ConfirmPasswordReset:output_type -> google.protobuf.Empty
+	58, // 88: ldt_hack.app.v1.AppService.ChangePassword:output_type -> google.protobuf.Empty
+	58, // 89: ldt_hack.app.v1.AppService.VerifyEmail:output_type -> google.protobuf.Empty
+	58, // 90: ldt_hack.app.v1.AppService.ResendVerification:output_type -> google.protobuf.Empty
+	58, // 91: ldt_hack.app.v1.AppService.ChangeEmail:output_type -> google.protobuf.Empty
+	23, // 92: ldt_hack.app.v1.AppService.SetupTwoFactor:output_type -> ldt_hack.app.v1.SetupTwoFactorResponse
+	27, // 93: ldt_hack.app.v1.AppService.EnableTwoFactor:output_type -> ldt_hack.app.v1.TwoFactorRecoveryCodes
+	58, // 94: ldt_hack.app.v1.AppService.DisableTwoFactor:output_type -> google.protobuf.Empty
+	27, // 95: ldt_hack.app.v1.AppService.RegenerateRecoveryCodes:output_type -> ldt_hack.app.v1.TwoFactorRecoveryCodes
+	8,  // 96: ldt_hack.app.v1.AppService.VerifyTwoFactor:output_type -> ldt_hack.app.v1.SessionToken
+	22, // 97: ldt_hack.app.v1.AppService.GetSessionUser:output_type -> ldt_hack.app.v1.GetSessionUserResponse
+	30, // 98: ldt_hack.app.v1.AppService.SendChatBotMessage:output_type -> ldt_hack.app.v1.SendChatBotMessageResponse
+	58, // 99: ldt_hack.app.v1.AppService.RateChatBot:output_type -> google.protobuf.Empty
+	32, // 100: ldt_hack.app.v1.AppService.ListConsultationTopics:output_type -> ldt_hack.app.v1.ListConsultationTopicsResponse
+	34, // 101: ldt_hack.app.v1.AppService.ListAvailableConsultationDates:output_type -> ldt_hack.app.v1.ListAvailableConsultationDatesResponse
+	36, // 102: ldt_hack.app.v1.AppService.ListAvailableConsultationSlots:output_type -> ldt_hack.app.v1.ListAvailableConsultationSlotsResponse
+	38, // 103: ldt_hack.app.v1.AppService.HoldConsultationSlot:output_type -> ldt_hack.app.v1.HoldConsultationSlotResponse
+	41, // 104: ldt_hack.app.v1.AppService.CreateConsultationAppointment:output_type -> ldt_hack.app.v1.CreateConsultationAppointmentResponse
+	58, // 105: ldt_hack.app.v1.AppService.CancelConsultationAppointment:output_type -> google.protobuf.Empty
+	58, // 106: ldt_hack.app.v1.AppService.CancelAuthorityConsultationAppointment:output_type -> google.protobuf.Empty
+	44, // 107: ldt_hack.app.v1.AppService.RescheduleConsultationAppointment:output_type -> ldt_hack.app.v1.RescheduleConsultationAppointmentResponse
+	58, // 108: ldt_hack.app.v1.AppService.JoinConsultationWaitlist:output_type -> google.protobuf.Empty
+	58, // 109: ldt_hack.app.v1.AppService.LeaveConsultationWaitlist:output_type -> google.protobuf.Empty
+	47, // 110: ldt_hack.app.v1.AppService.ListConsultationAppointments:output_type -> ldt_hack.app.v1.ListConsultationAppointmentsResponse
+	49, // 111: ldt_hack.app.v1.AppService.GetConsultationAttachment:output_type -> ldt_hack.app.v1.GetConsultationAttachmentResponse
+	75, // [75:112] is the sub-list for method output_type
+	38, // [38:75] is the sub-list for method input_type
+	37, // [37:38] is the sub-list for extension type_name
+	36, // [36:37] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
 }
 
 func init() { file_api_app_v1_app_proto_init() }
@@ -1947,6 +4302,18 @@ func file_api_app_v1_app_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_api_app_v1_app_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_v1_app_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BusinessUser); i {
 			case 0:
 				return &v.state
@@ -1958,8 +4325,80 @@ func file_api_app_v1_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_v1_app_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthorityUser); i {
+		file_api_app_v1_app_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorityUser); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_v1_app_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_v1_app_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBusinessUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_v1_app_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBusinessUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_v1_app_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_v1_app_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_v1_app_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartExternalLoginResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1970,8 +4409,8 @@ func file_api_app_v1_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_v1_app_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SessionToken); i {
+		file_api_app_v1_app_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompleteExternalLoginRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1982,8 +4421,8 @@ func file_api_app_v1_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_v1_app_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBusinessUserRequest); i {
+		file_api_app_v1_app_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSessionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1994,8 +4433,8 @@ func file_api_app_v1_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_v1_app_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateBusinessUserRequest); i {
+		file_api_app_v1_app_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2006,8 +4445,8 @@ func file_api_app_v1_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_v1_app_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSessionRequest); i {
+		file_api_app_v1_app_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestPasswordResetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2018,7 +4457,55 @@ func file_api_app_v1_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_v1_app_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_v1_app_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmPasswordResetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_v1_app_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyEmailRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_v1_app_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangePasswordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_v1_app_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeEmailRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_v1_app_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSessionUserResponse); i {
 			case 0:
 				return &v.state
@@ -2030,7 +4517,79 @@ func file_api_app_v1_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_v1_app_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_v1_app_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetupTwoFactorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_v1_app_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableTwoFactorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_v1_app_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableTwoFactorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_v1_app_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegenerateRecoveryCodesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_v1_app_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TwoFactorRecoveryCodes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_v1_app_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyTwoFactorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_v1_app_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SendChatBotMessageRequest); i {
 			case 0:
 				return &v.state
@@ -2042,7 +4601,7 @@ func file_api_app_v1_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_v1_app_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_v1_app_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SendChatBotMessageResponse); i {
 			case 0:
 				return &v.state
@@ -2054,7 +4613,7 @@ func file_api_app_v1_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_v1_app_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_v1_app_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RateChatBotRequest); i {
 			case 0:
 				return &v.state
@@ -2066,7 +4625,7 @@ func file_api_app_v1_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_v1_app_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_v1_app_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListConsultationTopicsResponse); i {
 			case 0:
 				return &v.state
@@ -2078,7 +4637,7 @@ func file_api_app_v1_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_v1_app_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_v1_app_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListAvailableConsultationDatesRequest); i {
 			case 0:
 				return &v.state
@@ -2090,7 +4649,7 @@ func file_api_app_v1_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_v1_app_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_v1_app_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListAvailableConsultationDatesResponse); i {
 			case 0:
 				return &v.state
@@ -2102,7 +4661,7 @@ func file_api_app_v1_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_v1_app_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_v1_app_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListAvailableConsultationSlotsRequest); i {
 			case 0:
 				return &v.state
@@ -2114,7 +4673,7 @@ func file_api_app_v1_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_v1_app_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_v1_app_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListAvailableConsultationSlotsResponse); i {
 			case 0:
 				return &v.state
@@ -2126,7 +4685,31 @@ func file_api_app_v1_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_v1_app_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_v1_app_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HoldConsultationSlotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_v1_app_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HoldConsultationSlotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_v1_app_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateConsultationAppointmentRequest); i {
 			case 0:
 				return &v.state
@@ -2138,7 +4721,19 @@ func file_api_app_v1_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_v1_app_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_v1_app_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsultationAttachmentUpload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_v1_app_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateConsultationAppointmentResponse); i {
 			case 0:
 				return &v.state
@@ -2150,7 +4745,7 @@ func file_api_app_v1_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_v1_app_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_v1_app_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CancelConsultationAppointmentRequest); i {
 			case 0:
 				return &v.state
@@ -2162,7 +4757,55 @@ func file_api_app_v1_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_v1_app_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_v1_app_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescheduleConsultationAppointmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_v1_app_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescheduleConsultationAppointmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_v1_app_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinConsultationWaitlistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_v1_app_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveConsultationWaitlistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_v1_app_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListConsultationAppointmentsResponse); i {
 			case 0:
 				return &v.state
@@ -2174,7 +4817,43 @@ func file_api_app_v1_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_v1_app_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_v1_app_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConsultationAttachmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_v1_app_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConsultationAttachmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_v1_app_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSessionsResponse_SessionInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_v1_app_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListConsultationTopicsResponse_AuthorityTopic); i {
 			case 0:
 				return &v.state
@@ -2186,7 +4865,7 @@ func file_api_app_v1_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_v1_app_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_v1_app_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListConsultationTopicsResponse_AuthorityTopics); i {
 			case 0:
 				return &v.state
@@ -2198,7 +4877,7 @@ func file_api_app_v1_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_v1_app_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_v1_app_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListAvailableConsultationSlotsResponse_ConsultationSlot); i {
 			case 0:
 				return &v.state
@@ -2210,7 +4889,7 @@ func file_api_app_v1_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_v1_app_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_v1_app_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListConsultationAppointmentsResponse_AppointmentInfo); i {
 			case 0:
 				return &v.state
@@ -2222,8 +4901,20 @@ func file_api_app_v1_app_proto_init() {
 				return nil
 			}
 		}
+		file_api_app_v1_app_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListConsultationAppointmentsResponse_Attachment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_api_app_v1_app_proto_msgTypes[6].OneofWrappers = []interface{}{
+	file_api_app_v1_app_proto_msgTypes[17].OneofWrappers = []interface{}{
 		(*GetSessionUserResponse_Business)(nil),
 		(*GetSessionUserResponse_Authority)(nil),
 	}
@@ -2232,15 +4923,16 @@ func file_api_app_v1_app_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_app_v1_app_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   23,
-			NumExtensions: 0,
+			NumEnums:      5,
+			NumMessages:   51,
+			NumExtensions: 1,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_app_v1_app_proto_goTypes,
 		DependencyIndexes: file_api_app_v1_app_proto_depIdxs,
 		EnumInfos:         file_api_app_v1_app_proto_enumTypes,
 		MessageInfos:      file_api_app_v1_app_proto_msgTypes,
+		ExtensionInfos:    file_api_app_v1_app_proto_extTypes,
 	}.Build()
 	File_api_app_v1_app_proto = out.File
 	file_api_app_v1_app_proto_rawDesc = nil
@@ -35,6 +35,63 @@ type AppServiceClient interface {
 	// CreateSession is a public endpoint for creating a new session for an existing user account,
 	// both for businesses and authorities. An initialized session token is returned on success.
 	CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*SessionToken, error)
+	// RefreshSession is a public endpoint for exchanging a refresh token, returned along with every session token,
+	// for a new session token. The used refresh token is invalidated and a new one is returned in its place.
+	RefreshSession(ctx context.Context, in *RefreshSessionRequest, opts ...grpc.CallOption) (*SessionToken, error)
+	// DeleteSession is an authenticated endpoint which logs out of the current session, revoking all of its tokens.
+	DeleteSession(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListSessions is an authenticated endpoint which lists the active sessions of the currently authenticated user.
+	ListSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	// RevokeSession is an authenticated endpoint which revokes one of the sessions retrieved via ListSessions.
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// StartExternalLogin is a public endpoint for starting a login via the external identity provider. The user must be
+	// sent to the returned authorization URL, after which the provider redirects them back to the app with the code
+	// and the state, which must then be passed to CompleteExternalLogin.
+	StartExternalLogin(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StartExternalLoginResponse, error)
+	// LinkExternalIdentity is an authenticated endpoint which starts linking the current user's account to their identity
+	// at the external provider, after which it can be used for logging in. The flow is completed via CompleteExternalLogin.
+	LinkExternalIdentity(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StartExternalLoginResponse, error)
+	// CompleteExternalLogin is a public endpoint which completes the login started via StartExternalLogin
+	// or LinkExternalIdentity. Users logging in for the first time without an account linked to their identity
+	// are registered as business users using the information returned by the provider.
+	CompleteExternalLogin(ctx context.Context, in *CompleteExternalLoginRequest, opts ...grpc.CallOption) (*SessionToken, error)
+	// RequestPasswordReset is a public endpoint for requesting a password reset token, which is sent to the user's email.
+	// The response is the same regardless of whether the account exists or not.
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ConfirmPasswordReset is a public endpoint for setting a new password using the token sent via RequestPasswordReset.
+	// The token can only be used once, and all of the account's sessions are revoked on success.
+	ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ChangePassword is an authenticated endpoint for both business and authority users for changing their password.
+	// All of the user's sessions except for the current one are revoked on success.
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// VerifyEmail is an authenticated endpoint for confirming the user's email using the code sent to it
+	// after registration or via ResendVerification. Consultations can't be booked until the email is confirmed.
+	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ResendVerification is an authenticated endpoint for sending a new email verification code,
+	// invalidating the previously sent one.
+	ResendVerification(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ChangeEmail is an authenticated endpoint for both business and authority users for changing their email.
+	// A verification code is sent to the new email, and the email is changed only after it is confirmed via VerifyEmail.
+	ChangeEmail(ctx context.Context, in *ChangeEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SetupTwoFactor is an authenticated endpoint for authority users for starting the enrollment into TOTP two-factor
+	// authentication. The returned secret and provisioning URI must be added to an authenticator app, after which
+	// the enrollment is confirmed via EnableTwoFactor. Can be called with a partial token if two-factor authentication
+	// is required by the user's authority but hasn't been enabled yet.
+	SetupTwoFactor(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SetupTwoFactorResponse, error)
+	// EnableTwoFactor is an authenticated endpoint for authority users for confirming the enrollment started via
+	// SetupTwoFactor using a code from the authenticator app. One-time recovery codes are returned on success,
+	// which can be used in place of the codes from the app.
+	EnableTwoFactor(ctx context.Context, in *EnableTwoFactorRequest, opts ...grpc.CallOption) (*TwoFactorRecoveryCodes, error)
+	// DisableTwoFactor is an authenticated endpoint for authority users for disabling two-factor authentication,
+	// unless it is required by the user's authority.
+	DisableTwoFactor(ctx context.Context, in *DisableTwoFactorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RegenerateRecoveryCodes is an authenticated endpoint for authority users for replacing
+	// all of the recovery codes with new ones.
+	RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*TwoFactorRecoveryCodes, error)
+	// VerifyTwoFactor is the second step of logging in for users with two-factor authentication, which exchanges
+	// the partial token returned by CreateSession and a code from the authenticator app or a recovery code
+	// for an initialized session token.
+	VerifyTwoFactor(ctx context.Context, in *VerifyTwoFactorRequest, opts ...grpc.CallOption) (*SessionToken, error)
 	// GetSessionUser is an authenticated endpoint which returns the information about the currently authenticated user.
 	GetSessionUser(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetSessionUserResponse, error)
 	// SendChatBotMessage is an authenticated endpoint for business users for sending message to
@@ -51,15 +108,37 @@ type AppServiceClient interface {
 	// ListAvailableConsultationSlots is an authenticated endpoint for business users for listing
 	// available consultation slots for a specific date during consultation
 	ListAvailableConsultationSlots(ctx context.Context, in *ListAvailableConsultationSlotsRequest, opts ...grpc.CallOption) (*ListAvailableConsultationSlotsResponse, error)
+	// HoldConsultationSlot is an authenticated endpoint for business users for temporarily reserving an inspector
+	// in a slot retrieved via ListAvailableConsultationSlots while the rest of the appointment is being filled in.
+	// The returned token should be passed to CreateConsultationAppointment before the hold expires.
+	// Holding a new slot releases the previous hold of the user.
+	HoldConsultationSlot(ctx context.Context, in *HoldConsultationSlotRequest, opts ...grpc.CallOption) (*HoldConsultationSlotResponse, error)
 	// CreateConsultationAppointment is an authenticated endpoint for business users for creating a consultation
 	// appointment using the information retrieved via ListConsultationTopics and ListAvailableConsultationSlots.
 	CreateConsultationAppointment(ctx context.Context, in *CreateConsultationAppointmentRequest, opts ...grpc.CallOption) (*CreateConsultationAppointmentResponse, error)
-	// CancelConsultationAppointment is an authenticated endpoint for business users for canceling a consultation
-	// appointment using the ID retrieved via ListConsultationAppointments.
+	// CancelConsultationAppointment is an authenticated endpoint for business and authority users for canceling
+	// a consultation appointment using the ID retrieved via ListConsultationAppointments.
+	// Inspectors can only cancel their own appointments and must specify the reason.
 	CancelConsultationAppointment(ctx context.Context, in *CancelConsultationAppointmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// CancelAuthorityConsultationAppointment is an authenticated endpoint for API keys with the write scope for canceling
+	// any consultation appointment of the key's authority. The reason is required and is shown to the business user.
+	CancelAuthorityConsultationAppointment(ctx context.Context, in *CancelConsultationAppointmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RescheduleConsultationAppointment is an authenticated endpoint for business users for moving a consultation
+	// appointment to another slot of the same authority. The appointment is left untouched if the new slot is already taken.
+	RescheduleConsultationAppointment(ctx context.Context, in *RescheduleConsultationAppointmentRequest, opts ...grpc.CallOption) (*RescheduleConsultationAppointmentResponse, error)
+	// JoinConsultationWaitlist is an authenticated endpoint for business users for waiting for a consultation slot
+	// of the authority's topic to open up in the date range. The first matching slot which opens up is booked
+	// for the users in the order of joining, and they are notified about the appointment by mail.
+	JoinConsultationWaitlist(ctx context.Context, in *JoinConsultationWaitlistRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// LeaveConsultationWaitlist is an authenticated endpoint for business users for no longer waiting
+	// for a consultation slot of the topic previously requested via JoinConsultationWaitlist.
+	LeaveConsultationWaitlist(ctx context.Context, in *LeaveConsultationWaitlistRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ListConsultationAppointments is an authenticated endpoint for business and authority users for listing
 	// created consultation appointments with their participation.
 	ListConsultationAppointments(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListConsultationAppointmentsResponse, error)
+	// GetConsultationAttachment is an authenticated endpoint for business and authority users for downloading
+	// a file attached to a consultation appointment using the ID retrieved via ListConsultationAppointments.
+	GetConsultationAttachment(ctx context.Context, in *GetConsultationAttachmentRequest, opts ...grpc.CallOption) (*GetConsultationAttachmentResponse, error)
 }
 
 type appServiceClient struct {
@@ -106,6 +185,168 @@ func (c *appServiceClient) CreateSession(ctx context.Context, in *CreateSessionR
 	return out, nil
 }
 
+func (c *appServiceClient) RefreshSession(ctx context.Context, in *RefreshSessionRequest, opts ...grpc.CallOption) (*SessionToken, error) {
+	out := new(SessionToken)
+	err := c.cc.Invoke(ctx, "/ldt_hack.app.v1.AppService/RefreshSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) DeleteSession(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/ldt_hack.app.v1.AppService/DeleteSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) ListSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, "/ldt_hack.app.v1.AppService/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/ldt_hack.app.v1.AppService/RevokeSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) StartExternalLogin(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StartExternalLoginResponse, error) {
+	out := new(StartExternalLoginResponse)
+	err := c.cc.Invoke(ctx, "/ldt_hack.app.v1.AppService/StartExternalLogin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) LinkExternalIdentity(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StartExternalLoginResponse, error) {
+	out := new(StartExternalLoginResponse)
+	err := c.cc.Invoke(ctx, "/ldt_hack.app.v1.AppService/LinkExternalIdentity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) CompleteExternalLogin(ctx context.Context, in *CompleteExternalLoginRequest, opts ...grpc.CallOption) (*SessionToken, error) {
+	out := new(SessionToken)
+	err := c.cc.Invoke(ctx, "/ldt_hack.app.v1.AppService/CompleteExternalLogin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/ldt_hack.app.v1.AppService/RequestPasswordReset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/ldt_hack.app.v1.AppService/ConfirmPasswordReset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/ldt_hack.app.v1.AppService/ChangePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/ldt_hack.app.v1.AppService/VerifyEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) ResendVerification(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/ldt_hack.app.v1.AppService/ResendVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) ChangeEmail(ctx context.Context, in *ChangeEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/ldt_hack.app.v1.AppService/ChangeEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) SetupTwoFactor(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SetupTwoFactorResponse, error) {
+	out := new(SetupTwoFactorResponse)
+	err := c.cc.Invoke(ctx, "/ldt_hack.app.v1.AppService/SetupTwoFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) EnableTwoFactor(ctx context.Context, in *EnableTwoFactorRequest, opts ...grpc.CallOption) (*TwoFactorRecoveryCodes, error) {
+	out := new(TwoFactorRecoveryCodes)
+	err := c.cc.Invoke(ctx, "/ldt_hack.app.v1.AppService/EnableTwoFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) DisableTwoFactor(ctx context.Context, in *DisableTwoFactorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/ldt_hack.app.v1.AppService/DisableTwoFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*TwoFactorRecoveryCodes, error) {
+	out := new(TwoFactorRecoveryCodes)
+	err := c.cc.Invoke(ctx, "/ldt_hack.app.v1.AppService/RegenerateRecoveryCodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) VerifyTwoFactor(ctx context.Context, in *VerifyTwoFactorRequest, opts ...grpc.CallOption) (*SessionToken, error) {
+	out := new(SessionToken)
+	err := c.cc.Invoke(ctx, "/ldt_hack.app.v1.AppService/VerifyTwoFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appServiceClient) GetSessionUser(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetSessionUserResponse, error) {
 	out := new(GetSessionUserResponse)
 	err := c.cc.Invoke(ctx, "/ldt_hack.app.v1.AppService/GetSessionUser", in, out, opts...)
@@ -160,6 +401,15 @@ func (c *appServiceClient) ListAvailableConsultationSlots(ctx context.Context, i
 	return out, nil
 }
 
+func (c *appServiceClient) HoldConsultationSlot(ctx context.Context, in *HoldConsultationSlotRequest, opts ...grpc.CallOption) (*HoldConsultationSlotResponse, error) {
+	out := new(HoldConsultationSlotResponse)
+	err := c.cc.Invoke(ctx, "/ldt_hack.app.v1.AppService/HoldConsultationSlot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appServiceClient) CreateConsultationAppointment(ctx context.Context, in *CreateConsultationAppointmentRequest, opts ...grpc.CallOption) (*CreateConsultationAppointmentResponse, error) {
 	out := new(CreateConsultationAppointmentResponse)
 	err := c.cc.Invoke(ctx, "/ldt_hack.app.v1.AppService/CreateConsultationAppointment", in, out, opts...)
@@ -178,6 +428,42 @@ func (c *appServiceClient) CancelConsultationAppointment(ctx context.Context, in
 	return out, nil
 }
 
+func (c *appServiceClient) CancelAuthorityConsultationAppointment(ctx context.Context, in *CancelConsultationAppointmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/ldt_hack.app.v1.AppService/CancelAuthorityConsultationAppointment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) RescheduleConsultationAppointment(ctx context.Context, in *RescheduleConsultationAppointmentRequest, opts ...grpc.CallOption) (*RescheduleConsultationAppointmentResponse, error) {
+	out := new(RescheduleConsultationAppointmentResponse)
+	err := c.cc.Invoke(ctx, "/ldt_hack.app.v1.AppService/RescheduleConsultationAppointment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) JoinConsultationWaitlist(ctx context.Context, in *JoinConsultationWaitlistRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/ldt_hack.app.v1.AppService/JoinConsultationWaitlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) LeaveConsultationWaitlist(ctx context.Context, in *LeaveConsultationWaitlistRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/ldt_hack.app.v1.AppService/LeaveConsultationWaitlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appServiceClient) ListConsultationAppointments(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListConsultationAppointmentsResponse, error) {
 	out := new(ListConsultationAppointmentsResponse)
 	err := c.cc.Invoke(ctx, "/ldt_hack.app.v1.AppService/ListConsultationAppointments", in, out, opts...)
@@ -187,6 +473,15 @@ func (c *appServiceClient) ListConsultationAppointments(ctx context.Context, in
 	return out, nil
 }
 
+func (c *appServiceClient) GetConsultationAttachment(ctx context.Context, in *GetConsultationAttachmentRequest, opts ...grpc.CallOption) (*GetConsultationAttachmentResponse, error) {
+	out := new(GetConsultationAttachmentResponse)
+	err := c.cc.Invoke(ctx, "/ldt_hack.app.v1.AppService/GetConsultationAttachment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AppServiceServer is the server API for AppService service.
 // All implementations must embed UnimplementedAppServiceServer
 // for forward compatibility
@@ -203,6 +498,63 @@ type AppServiceServer interface {
 	// CreateSession is a public endpoint for creating a new session for an existing user account,
 	// both for businesses and authorities. An initialized session token is returned on success.
 	CreateSession(context.Context, *CreateSessionRequest) (*SessionToken, error)
+	// RefreshSession is a public endpoint for exchanging a refresh token, returned along with every session token,
+	// for a new session token. The used refresh token is invalidated and a new one is returned in its place.
+	RefreshSession(context.Context, *RefreshSessionRequest) (*SessionToken, error)
+	// DeleteSession is an authenticated endpoint which logs out of the current session, revoking all of its tokens.
+	DeleteSession(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// ListSessions is an authenticated endpoint which lists the active sessions of the currently authenticated user.
+	ListSessions(context.Context, *emptypb.Empty) (*ListSessionsResponse, error)
+	// RevokeSession is an authenticated endpoint which revokes one of the sessions retrieved via ListSessions.
+	RevokeSession(context.Context, *RevokeSessionRequest) (*emptypb.Empty, error)
+	// StartExternalLogin is a public endpoint for starting a login via the external identity provider. The user must be
+	// sent to the returned authorization URL, after which the provider redirects them back to the app with the code
+	// and the state, which must then be passed to CompleteExternalLogin.
+	StartExternalLogin(context.Context, *emptypb.Empty) (*StartExternalLoginResponse, error)
+	// LinkExternalIdentity is an authenticated endpoint which starts linking the current user's account to their identity
+	// at the external provider, after which it can be used for logging in. The flow is completed via CompleteExternalLogin.
+	LinkExternalIdentity(context.Context, *emptypb.Empty) (*StartExternalLoginResponse, error)
+	// CompleteExternalLogin is a public endpoint which completes the login started via StartExternalLogin
+	// or LinkExternalIdentity. Users logging in for the first time without an account linked to their identity
+	// are registered as business users using the information returned by the provider.
+	CompleteExternalLogin(context.Context, *CompleteExternalLoginRequest) (*SessionToken, error)
+	// RequestPasswordReset is a public endpoint for requesting a password reset token, which is sent to the user's email.
+	// The response is the same regardless of whether the account exists or not.
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*emptypb.Empty, error)
+	// ConfirmPasswordReset is a public endpoint for setting a new password using the token sent via RequestPasswordReset.
+	// The token can only be used once, and all of the account's sessions are revoked on success.
+	ConfirmPasswordReset(context.Context, *ConfirmPasswordResetRequest) (*emptypb.Empty, error)
+	// ChangePassword is an authenticated endpoint for both business and authority users for changing their password.
+	// All of the user's sessions except for the current one are revoked on success.
+	ChangePassword(context.Context, *ChangePasswordRequest) (*emptypb.Empty, error)
+	// VerifyEmail is an authenticated endpoint for confirming the user's email using the code sent to it
+	// after registration or via ResendVerification. Consultations can't be booked until the email is confirmed.
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*emptypb.Empty, error)
+	// ResendVerification is an authenticated endpoint for sending a new email verification code,
+	// invalidating the previously sent one.
+	ResendVerification(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// ChangeEmail is an authenticated endpoint for both business and authority users for changing their email.
+	// A verification code is sent to the new email, and the email is changed only after it is confirmed via VerifyEmail.
+	ChangeEmail(context.Context, *ChangeEmailRequest) (*emptypb.Empty, error)
+	// SetupTwoFactor is an authenticated endpoint for authority users for starting the enrollment into TOTP two-factor
+	// authentication. The returned secret and provisioning URI must be added to an authenticator app, after which
+	// the enrollment is confirmed via EnableTwoFactor. Can be called with a partial token if two-factor authentication
+	// is required by the user's authority but hasn't been enabled yet.
+	SetupTwoFactor(context.Context, *emptypb.Empty) (*SetupTwoFactorResponse, error)
+	// EnableTwoFactor is an authenticated endpoint for authority users for confirming the enrollment started via
+	// SetupTwoFactor using a code from the authenticator app. One-time recovery codes are returned on success,
+	// which can be used in place of the codes from the app.
+	EnableTwoFactor(context.Context, *EnableTwoFactorRequest) (*TwoFactorRecoveryCodes, error)
+	// DisableTwoFactor is an authenticated endpoint for authority users for disabling two-factor authentication,
+	// unless it is required by the user's authority.
+	DisableTwoFactor(context.Context, *DisableTwoFactorRequest) (*emptypb.Empty, error)
+	// RegenerateRecoveryCodes is an authenticated endpoint for authority users for replacing
+	// all of the recovery codes with new ones.
+	RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesRequest) (*TwoFactorRecoveryCodes, error)
+	// VerifyTwoFactor is the second step of logging in for users with two-factor authentication, which exchanges
+	// the partial token returned by CreateSession and a code from the authenticator app or a recovery code
+	// for an initialized session token.
+	VerifyTwoFactor(context.Context, *VerifyTwoFactorRequest) (*SessionToken, error)
 	// GetSessionUser is an authenticated endpoint which returns the information about the currently authenticated user.
 	GetSessionUser(context.Context, *emptypb.Empty) (*GetSessionUserResponse, error)
 	// SendChatBotMessage is an authenticated endpoint for business users for sending message to
@@ -219,15 +571,37 @@ type AppServiceServer interface {
 	// ListAvailableConsultationSlots is an authenticated endpoint for business users for listing
 	// available consultation slots for a specific date during consultation
 	ListAvailableConsultationSlots(context.Context, *ListAvailableConsultationSlotsRequest) (*ListAvailableConsultationSlotsResponse, error)
+	// HoldConsultationSlot is an authenticated endpoint for business users for temporarily reserving an inspector
+	// in a slot retrieved via ListAvailableConsultationSlots while the rest of the appointment is being filled in.
+	// The returned token should be passed to CreateConsultationAppointment before the hold expires.
+	// Holding a new slot releases the previous hold of the user.
+	HoldConsultationSlot(context.Context, *HoldConsultationSlotRequest) (*HoldConsultationSlotResponse, error)
 	// CreateConsultationAppointment is an authenticated endpoint for business users for creating a consultation
 	// appointment using the information retrieved via ListConsultationTopics and ListAvailableConsultationSlots.
 	CreateConsultationAppointment(context.Context, *CreateConsultationAppointmentRequest) (*CreateConsultationAppointmentResponse, error)
-	// CancelConsultationAppointment is an authenticated endpoint for business users for canceling a consultation
-	// appointment using the ID retrieved via ListConsultationAppointments.
+	// CancelConsultationAppointment is an authenticated endpoint for business and authority users for canceling
+	// a consultation appointment using the ID retrieved via ListConsultationAppointments.
+	// Inspectors can only cancel their own appointments and must specify the reason.
 	CancelConsultationAppointment(context.Context, *CancelConsultationAppointmentRequest) (*emptypb.Empty, error)
+	// CancelAuthorityConsultationAppointment is an authenticated endpoint for API keys with the write scope for canceling
+	// any consultation appointment of the key's authority. The reason is required and is shown to the business user.
+	CancelAuthorityConsultationAppointment(context.Context, *CancelConsultationAppointmentRequest) (*emptypb.Empty, error)
+	// RescheduleConsultationAppointment is an authenticated endpoint for business users for moving a consultation
+	// appointment to another slot of the same authority. The appointment is left untouched if the new slot is already taken.
+	RescheduleConsultationAppointment(context.Context, *RescheduleConsultationAppointmentRequest) (*RescheduleConsultationAppointmentResponse, error)
+	// JoinConsultationWaitlist is an authenticated endpoint for business users for waiting for a consultation slot
+	// of the authority's topic to open up in the date range. The first matching slot which opens up is booked
+	// for the users in the order of joining, and they are notified about the appointment by mail.
+	JoinConsultationWaitlist(context.Context, *JoinConsultationWaitlistRequest) (*emptypb.Empty, error)
+	// LeaveConsultationWaitlist is an authenticated endpoint for business users for no longer waiting
+	// for a consultation slot of the topic previously requested via JoinConsultationWaitlist.
+	LeaveConsultationWaitlist(context.Context, *LeaveConsultationWaitlistRequest) (*emptypb.Empty, error)
 	// ListConsultationAppointments is an authenticated endpoint for business and authority users for listing
 	// created consultation appointments with their participation.
 	ListConsultationAppointments(context.Context, *emptypb.Empty) (*ListConsultationAppointmentsResponse, error)
+	// GetConsultationAttachment is an authenticated endpoint for business and authority users for downloading
+	// a file attached to a consultation appointment using the ID retrieved via ListConsultationAppointments.
+	GetConsultationAttachment(context.Context, *GetConsultationAttachmentRequest) (*GetConsultationAttachmentResponse, error)
 	mustEmbedUnimplementedAppServiceServer()
 }
 
@@ -247,6 +621,60 @@ func (UnimplementedAppServiceServer) DeleteBusinessUser(context.Context, *emptyp
 func (UnimplementedAppServiceServer) CreateSession(context.Context, *CreateSessionRequest) (*SessionToken, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSession not implemented")
 }
+func (UnimplementedAppServiceServer) RefreshSession(context.Context, *RefreshSessionRequest) (*SessionToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshSession not implemented")
+}
+func (UnimplementedAppServiceServer) DeleteSession(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSession not implemented")
+}
+func (UnimplementedAppServiceServer) ListSessions(context.Context, *emptypb.Empty) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedAppServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedAppServiceServer) StartExternalLogin(context.Context, *emptypb.Empty) (*StartExternalLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartExternalLogin not implemented")
+}
+func (UnimplementedAppServiceServer) LinkExternalIdentity(context.Context, *emptypb.Empty) (*StartExternalLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkExternalIdentity not implemented")
+}
+func (UnimplementedAppServiceServer) CompleteExternalLogin(context.Context, *CompleteExternalLoginRequest) (*SessionToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteExternalLogin not implemented")
+}
+func (UnimplementedAppServiceServer) RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedAppServiceServer) ConfirmPasswordReset(context.Context, *ConfirmPasswordResetRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmPasswordReset not implemented")
+}
+func (UnimplementedAppServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedAppServiceServer) VerifyEmail(context.Context, *VerifyEmailRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+}
+func (UnimplementedAppServiceServer) ResendVerification(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResendVerification not implemented")
+}
+func (UnimplementedAppServiceServer) ChangeEmail(context.Context, *ChangeEmailRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeEmail not implemented")
+}
+func (UnimplementedAppServiceServer) SetupTwoFactor(context.Context, *emptypb.Empty) (*SetupTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetupTwoFactor not implemented")
+}
+func (UnimplementedAppServiceServer) EnableTwoFactor(context.Context, *EnableTwoFactorRequest) (*TwoFactorRecoveryCodes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableTwoFactor not implemented")
+}
+func (UnimplementedAppServiceServer) DisableTwoFactor(context.Context, *DisableTwoFactorRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTwoFactor not implemented")
+}
+func (UnimplementedAppServiceServer) RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesRequest) (*TwoFactorRecoveryCodes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateRecoveryCodes not implemented")
+}
+func (UnimplementedAppServiceServer) VerifyTwoFactor(context.Context, *VerifyTwoFactorRequest) (*SessionToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyTwoFactor not implemented")
+}
 func (UnimplementedAppServiceServer) GetSessionUser(context.Context, *emptypb.Empty) (*GetSessionUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSessionUser not implemented")
 }
@@ -265,15 +693,33 @@ func (UnimplementedAppServiceServer) ListAvailableConsultationDates(context.Cont
 func (UnimplementedAppServiceServer) ListAvailableConsultationSlots(context.Context, *ListAvailableConsultationSlotsRequest) (*ListAvailableConsultationSlotsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAvailableConsultationSlots not implemented")
 }
+func (UnimplementedAppServiceServer) HoldConsultationSlot(context.Context, *HoldConsultationSlotRequest) (*HoldConsultationSlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HoldConsultationSlot not implemented")
+}
 func (UnimplementedAppServiceServer) CreateConsultationAppointment(context.Context, *CreateConsultationAppointmentRequest) (*CreateConsultationAppointmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateConsultationAppointment not implemented")
 }
 func (UnimplementedAppServiceServer) CancelConsultationAppointment(context.Context, *CancelConsultationAppointmentRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelConsultationAppointment not implemented")
 }
+func (UnimplementedAppServiceServer) CancelAuthorityConsultationAppointment(context.Context, *CancelConsultationAppointmentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAuthorityConsultationAppointment not implemented")
+}
+func (UnimplementedAppServiceServer) RescheduleConsultationAppointment(context.Context, *RescheduleConsultationAppointmentRequest) (*RescheduleConsultationAppointmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleConsultationAppointment not implemented")
+}
+func (UnimplementedAppServiceServer) JoinConsultationWaitlist(context.Context, *JoinConsultationWaitlistRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinConsultationWaitlist not implemented")
+}
+func (UnimplementedAppServiceServer) LeaveConsultationWaitlist(context.Context, *LeaveConsultationWaitlistRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveConsultationWaitlist not implemented")
+}
 func (UnimplementedAppServiceServer) ListConsultationAppointments(context.Context, *emptypb.Empty) (*ListConsultationAppointmentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListConsultationAppointments not implemented")
 }
+func (UnimplementedAppServiceServer) GetConsultationAttachment(context.Context, *GetConsultationAttachmentRequest) (*GetConsultationAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsultationAttachment not implemented")
+}
 func (UnimplementedAppServiceServer) mustEmbedUnimplementedAppServiceServer() {}
 
 // UnsafeAppServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -359,183 +805,615 @@ func _AppService_CreateSession_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppService_GetSessionUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _AppService_RefreshSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).RefreshSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ldt_hack.app.v1.AppService/RefreshSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).RefreshSession(ctx, req.(*RefreshSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_DeleteSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServiceServer).GetSessionUser(ctx, in)
+		return srv.(AppServiceServer).DeleteSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ldt_hack.app.v1.AppService/GetSessionUser",
+		FullMethod: "/ldt_hack.app.v1.AppService/DeleteSession",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppServiceServer).GetSessionUser(ctx, req.(*emptypb.Empty))
+		return srv.(AppServiceServer).DeleteSession(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppService_SendChatBotMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendChatBotMessageRequest)
+func _AppService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServiceServer).SendChatBotMessage(ctx, in)
+		return srv.(AppServiceServer).ListSessions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ldt_hack.app.v1.AppService/SendChatBotMessage",
+		FullMethod: "/ldt_hack.app.v1.AppService/ListSessions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppServiceServer).SendChatBotMessage(ctx, req.(*SendChatBotMessageRequest))
+		return srv.(AppServiceServer).ListSessions(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppService_RateChatBot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RateChatBotRequest)
+func _AppService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServiceServer).RateChatBot(ctx, in)
+		return srv.(AppServiceServer).RevokeSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ldt_hack.app.v1.AppService/RateChatBot",
+		FullMethod: "/ldt_hack.app.v1.AppService/RevokeSession",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppServiceServer).RateChatBot(ctx, req.(*RateChatBotRequest))
+		return srv.(AppServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppService_ListConsultationTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _AppService_StartExternalLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServiceServer).ListConsultationTopics(ctx, in)
+		return srv.(AppServiceServer).StartExternalLogin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ldt_hack.app.v1.AppService/ListConsultationTopics",
+		FullMethod: "/ldt_hack.app.v1.AppService/StartExternalLogin",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppServiceServer).ListConsultationTopics(ctx, req.(*emptypb.Empty))
+		return srv.(AppServiceServer).StartExternalLogin(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppService_ListAvailableConsultationDates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAvailableConsultationDatesRequest)
+func _AppService_LinkExternalIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServiceServer).ListAvailableConsultationDates(ctx, in)
+		return srv.(AppServiceServer).LinkExternalIdentity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ldt_hack.app.v1.AppService/ListAvailableConsultationDates",
+		FullMethod: "/ldt_hack.app.v1.AppService/LinkExternalIdentity",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppServiceServer).ListAvailableConsultationDates(ctx, req.(*ListAvailableConsultationDatesRequest))
+		return srv.(AppServiceServer).LinkExternalIdentity(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppService_ListAvailableConsultationSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAvailableConsultationSlotsRequest)
+func _AppService_CompleteExternalLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteExternalLoginRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServiceServer).ListAvailableConsultationSlots(ctx, in)
+		return srv.(AppServiceServer).CompleteExternalLogin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ldt_hack.app.v1.AppService/ListAvailableConsultationSlots",
+		FullMethod: "/ldt_hack.app.v1.AppService/CompleteExternalLogin",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppServiceServer).ListAvailableConsultationSlots(ctx, req.(*ListAvailableConsultationSlotsRequest))
+		return srv.(AppServiceServer).CompleteExternalLogin(ctx, req.(*CompleteExternalLoginRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppService_CreateConsultationAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateConsultationAppointmentRequest)
+func _AppService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServiceServer).CreateConsultationAppointment(ctx, in)
+		return srv.(AppServiceServer).RequestPasswordReset(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ldt_hack.app.v1.AppService/CreateConsultationAppointment",
+		FullMethod: "/ldt_hack.app.v1.AppService/RequestPasswordReset",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppServiceServer).CreateConsultationAppointment(ctx, req.(*CreateConsultationAppointmentRequest))
+		return srv.(AppServiceServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppService_CancelConsultationAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelConsultationAppointmentRequest)
+func _AppService_ConfirmPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmPasswordResetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServiceServer).CancelConsultationAppointment(ctx, in)
+		return srv.(AppServiceServer).ConfirmPasswordReset(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ldt_hack.app.v1.AppService/CancelConsultationAppointment",
+		FullMethod: "/ldt_hack.app.v1.AppService/ConfirmPasswordReset",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppServiceServer).CancelConsultationAppointment(ctx, req.(*CancelConsultationAppointmentRequest))
+		return srv.(AppServiceServer).ConfirmPasswordReset(ctx, req.(*ConfirmPasswordResetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppService_ListConsultationAppointments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _AppService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServiceServer).ListConsultationAppointments(ctx, in)
+		return srv.(AppServiceServer).ChangePassword(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ldt_hack.app.v1.AppService/ListConsultationAppointments",
+		FullMethod: "/ldt_hack.app.v1.AppService/ChangePassword",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppServiceServer).ListConsultationAppointments(ctx, req.(*emptypb.Empty))
+		return srv.(AppServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// AppService_ServiceDesc is the grpc.ServiceDesc for AppService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var AppService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "ldt_hack.app.v1.AppService",
-	HandlerType: (*AppServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateBusinessUser",
-			Handler:    _AppService_CreateBusinessUser_Handler,
-		},
-		{
-			MethodName: "UpdateBusinessUser",
-			Handler:    _AppService_UpdateBusinessUser_Handler,
-		},
+func _AppService_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ldt_hack.app.v1.AppService/VerifyEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_ResendVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).ResendVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ldt_hack.app.v1.AppService/ResendVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).ResendVerification(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_ChangeEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).ChangeEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ldt_hack.app.v1.AppService/ChangeEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).ChangeEmail(ctx, req.(*ChangeEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_SetupTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).SetupTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ldt_hack.app.v1.AppService/SetupTwoFactor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).SetupTwoFactor(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_EnableTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).EnableTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ldt_hack.app.v1.AppService/EnableTwoFactor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).EnableTwoFactor(ctx, req.(*EnableTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_DisableTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).DisableTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ldt_hack.app.v1.AppService/DisableTwoFactor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).DisableTwoFactor(ctx, req.(*DisableTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_RegenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateRecoveryCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).RegenerateRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ldt_hack.app.v1.AppService/RegenerateRecoveryCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).RegenerateRecoveryCodes(ctx, req.(*RegenerateRecoveryCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_VerifyTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).VerifyTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ldt_hack.app.v1.AppService/VerifyTwoFactor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).VerifyTwoFactor(ctx, req.(*VerifyTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_GetSessionUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).GetSessionUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ldt_hack.app.v1.AppService/GetSessionUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).GetSessionUser(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_SendChatBotMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendChatBotMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).SendChatBotMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ldt_hack.app.v1.AppService/SendChatBotMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).SendChatBotMessage(ctx, req.(*SendChatBotMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_RateChatBot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateChatBotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).RateChatBot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ldt_hack.app.v1.AppService/RateChatBot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).RateChatBot(ctx, req.(*RateChatBotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_ListConsultationTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).ListConsultationTopics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ldt_hack.app.v1.AppService/ListConsultationTopics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).ListConsultationTopics(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_ListAvailableConsultationDates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAvailableConsultationDatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).ListAvailableConsultationDates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ldt_hack.app.v1.AppService/ListAvailableConsultationDates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).ListAvailableConsultationDates(ctx, req.(*ListAvailableConsultationDatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_ListAvailableConsultationSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAvailableConsultationSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).ListAvailableConsultationSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ldt_hack.app.v1.AppService/ListAvailableConsultationSlots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).ListAvailableConsultationSlots(ctx, req.(*ListAvailableConsultationSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_HoldConsultationSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HoldConsultationSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).HoldConsultationSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ldt_hack.app.v1.AppService/HoldConsultationSlot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).HoldConsultationSlot(ctx, req.(*HoldConsultationSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_CreateConsultationAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConsultationAppointmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).CreateConsultationAppointment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ldt_hack.app.v1.AppService/CreateConsultationAppointment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).CreateConsultationAppointment(ctx, req.(*CreateConsultationAppointmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_CancelConsultationAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelConsultationAppointmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).CancelConsultationAppointment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ldt_hack.app.v1.AppService/CancelConsultationAppointment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).CancelConsultationAppointment(ctx, req.(*CancelConsultationAppointmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_CancelAuthorityConsultationAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelConsultationAppointmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).CancelAuthorityConsultationAppointment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ldt_hack.app.v1.AppService/CancelAuthorityConsultationAppointment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).CancelAuthorityConsultationAppointment(ctx, req.(*CancelConsultationAppointmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_RescheduleConsultationAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleConsultationAppointmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).RescheduleConsultationAppointment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ldt_hack.app.v1.AppService/RescheduleConsultationAppointment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).RescheduleConsultationAppointment(ctx, req.(*RescheduleConsultationAppointmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_JoinConsultationWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinConsultationWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).JoinConsultationWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ldt_hack.app.v1.AppService/JoinConsultationWaitlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).JoinConsultationWaitlist(ctx, req.(*JoinConsultationWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_LeaveConsultationWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveConsultationWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).LeaveConsultationWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ldt_hack.app.v1.AppService/LeaveConsultationWaitlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).LeaveConsultationWaitlist(ctx, req.(*LeaveConsultationWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_ListConsultationAppointments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).ListConsultationAppointments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ldt_hack.app.v1.AppService/ListConsultationAppointments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).ListConsultationAppointments(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_GetConsultationAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConsultationAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).GetConsultationAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ldt_hack.app.v1.AppService/GetConsultationAttachment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).GetConsultationAttachment(ctx, req.(*GetConsultationAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AppService_ServiceDesc is the grpc.ServiceDesc for AppService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AppService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ldt_hack.app.v1.AppService",
+	HandlerType: (*AppServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateBusinessUser",
+			Handler:    _AppService_CreateBusinessUser_Handler,
+		},
+		{
+			MethodName: "UpdateBusinessUser",
+			Handler:    _AppService_UpdateBusinessUser_Handler,
+		},
 		{
 			MethodName: "DeleteBusinessUser",
 			Handler:    _AppService_DeleteBusinessUser_Handler,
@@ -544,6 +1422,78 @@ var AppService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateSession",
 			Handler:    _AppService_CreateSession_Handler,
 		},
+		{
+			MethodName: "RefreshSession",
+			Handler:    _AppService_RefreshSession_Handler,
+		},
+		{
+			MethodName: "DeleteSession",
+			Handler:    _AppService_DeleteSession_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _AppService_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _AppService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "StartExternalLogin",
+			Handler:    _AppService_StartExternalLogin_Handler,
+		},
+		{
+			MethodName: "LinkExternalIdentity",
+			Handler:    _AppService_LinkExternalIdentity_Handler,
+		},
+		{
+			MethodName: "CompleteExternalLogin",
+			Handler:    _AppService_CompleteExternalLogin_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _AppService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ConfirmPasswordReset",
+			Handler:    _AppService_ConfirmPasswordReset_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _AppService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _AppService_VerifyEmail_Handler,
+		},
+		{
+			MethodName: "ResendVerification",
+			Handler:    _AppService_ResendVerification_Handler,
+		},
+		{
+			MethodName: "ChangeEmail",
+			Handler:    _AppService_ChangeEmail_Handler,
+		},
+		{
+			MethodName: "SetupTwoFactor",
+			Handler:    _AppService_SetupTwoFactor_Handler,
+		},
+		{
+			MethodName: "EnableTwoFactor",
+			Handler:    _AppService_EnableTwoFactor_Handler,
+		},
+		{
+			MethodName: "DisableTwoFactor",
+			Handler:    _AppService_DisableTwoFactor_Handler,
+		},
+		{
+			MethodName: "RegenerateRecoveryCodes",
+			Handler:    _AppService_RegenerateRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "VerifyTwoFactor",
+			Handler:    _AppService_VerifyTwoFactor_Handler,
+		},
 		{
 			MethodName: "GetSessionUser",
 			Handler:    _AppService_GetSessionUser_Handler,
@@ -568,6 +1518,10 @@ var AppService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListAvailableConsultationSlots",
 			Handler:    _AppService_ListAvailableConsultationSlots_Handler,
 		},
+		{
+			MethodName: "HoldConsultationSlot",
+			Handler:    _AppService_HoldConsultationSlot_Handler,
+		},
 		{
 			MethodName: "CreateConsultationAppointment",
 			Handler:    _AppService_CreateConsultationAppointment_Handler,
@@ -576,10 +1530,30 @@ var AppService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelConsultationAppointment",
 			Handler:    _AppService_CancelConsultationAppointment_Handler,
 		},
+		{
+			MethodName: "CancelAuthorityConsultationAppointment",
+			Handler:    _AppService_CancelAuthorityConsultationAppointment_Handler,
+		},
+		{
+			MethodName: "RescheduleConsultationAppointment",
+			Handler:    _AppService_RescheduleConsultationAppointment_Handler,
+		},
+		{
+			MethodName: "JoinConsultationWaitlist",
+			Handler:    _AppService_JoinConsultationWaitlist_Handler,
+		},
+		{
+			MethodName: "LeaveConsultationWaitlist",
+			Handler:    _AppService_LeaveConsultationWaitlist_Handler,
+		},
 		{
 			MethodName: "ListConsultationAppointments",
 			Handler:    _AppService_ListConsultationAppointments_Handler,
 		},
+		{
+			MethodName: "GetConsultationAttachment",
+			Handler:    _AppService_GetConsultationAttachment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/app/v1/app.proto",
@@ -1,7 +1,9 @@
 package config
 
 import (
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -9,24 +11,122 @@ import (
 const (
 	// DSN connection string to the Postgres database
 	PostgresDSN = "postgres.dsn"
-	// Path to private key PEM file
+	// Path to private key PEM file or a directory of them, see auth.LoadKeySet
 	JWTPath = "jwt.path"
+	// Interval at which the JWT keys are reloaded
+	JWTReloadInterval = "jwt.reload_interval"
 	// Bind address for the gRPC server
 	GRPCAddr = "grpc.addr"
 	// Bind address for the HTTP server
 	HTTPAddr = "http.addr"
 	// Log level to use for initializing the logger
 	LogLevel = "log.level"
-	// Admin username:password_hash pair
+	// Admin username:password_hash pair used to create the first super admin if no admins exist
 	AdminCredentials = "admin.credentials"
+	// Whether the admin cookies are only sent over HTTPS
+	AdminCookieSecure = "admin.cookie_secure"
+	// SameSite attribute of the admin cookies: strict, lax or none
+	AdminCookieSameSite = "admin.cookie_same_site"
+	// Admin sessions expire after this period of inactivity
+	AdminSessionIdleTimeout = "admin.session_idle_timeout"
+	// Admin sessions can't be renewed for longer than this period after logging in
+	AdminSessionMaxAge = "admin.session_max_age"
 	// Base URL to rasa API
 	RasaURL = "rasa.url"
+	// Kind of sender used for mail delivery, see mail.NewSender
+	MailSender = "mail.sender"
+	// Directory where mail messages are stored when using the file sender
+	MailDir = "mail.dir"
+	// Name under which the identities of the external OIDC provider's users are stored
+	OIDCName = "oidc.name"
+	// Issuer URL of the external OIDC provider, external login is disabled if empty
+	OIDCIssuer = "oidc.issuer"
+	// Credentials of the client registered with the OIDC provider
+	OIDCClientID     = "oidc.client_id"
+	OIDCClientSecret = "oidc.client_secret"
+	// URL to which the OIDC provider redirects users after logging in, usually a deep link into the app
+	OIDCRedirectURL = "oidc.redirect_url"
+	// Space-separated scopes requested from the OIDC provider
+	OIDCScopes = "oidc.scopes"
+	// Maximum number of accounts and sessions each cached by the app service, caching is disabled if zero
+	CacheSize = "cache.size"
+	// Time for which the accounts and sessions are cached in case an invalidation is missed
+	CacheTTL = "cache.ttl"
+	// Period for which the consultation slots are generated from the slot templates in advance
+	SlotsHorizon = "slots.horizon"
+	// Interval at which the consultation slots are generated
+	SlotsGenerateInterval = "slots.generate_interval"
+	// Interval at which the consultation waitlist is processed in addition to the changes of the appointments and slots
+	WaitlistInterval = "waitlist.interval"
+	// Time for which the consultation slots are held for the business users during booking
+	SlotHoldTTL = "slot_hold.ttl"
+	// Interval at which the expired consultation slot holds are deleted
+	SlotHoldSweepInterval = "slot_hold.sweep_interval"
+	// Kind of store used for the files attached to the appointments, see blob.NewStore
+	BlobStore = "blob.store"
+	// Directory where the files are stored when using the filesystem store
+	BlobDir = "blob.dir"
+	// Interval at which the stored files of the deleted attachments are deleted
+	BlobSweepInterval = "blob.sweep_interval"
+	// Minimum and maximum length of new passwords
+	PasswordMinLength = "password.min_length"
+	PasswordMaxLength = "password.max_length"
+	// Number of character classes which must be used in new passwords, see passwords.Policy
+	PasswordMinClasses = "password.min_classes"
+	// Path to a file with additional denied passwords, one per line
+	PasswordDenyList = "password.deny_list"
+	// Parameters of the argon2id hashes of new passwords, the existing hashes are upgraded on the next login
+	PasswordArgon2Memory     = "password.argon2.memory"
+	PasswordArgon2Iterations = "password.argon2.iterations"
+	PasswordArgon2Threads    = "password.argon2.threads"
+	PasswordArgon2SaltLength = "password.argon2.salt_length"
+	PasswordArgon2KeyLength  = "password.argon2.key_length"
 )
 
 const (
 	defaultGRPCAddr = ":9081"
 	defaultHTTPAddr = ":9080"
-	defaultJWTPath  = "/var/run/secrets/jwt.pem"
+	defaultJWTPath  = "/var/run/secrets"
+
+	defaultJWTReloadInterval = time.Minute
+
+	defaultAdminCookieSecure       = true
+	defaultAdminCookieSameSite     = "strict"
+	defaultAdminSessionIdleTimeout = time.Minute * 30
+	defaultAdminSessionMaxAge      = time.Hour * 12
+
+	defaultMailSender = "log"
+	defaultMailDir    = ".data/mail"
+
+	defaultOIDCName   = "oidc"
+	defaultOIDCScopes = "openid email profile phone"
+
+	defaultCacheSize = 10000
+	defaultCacheTTL  = time.Minute
+
+	defaultSlotsHorizon          = time.Hour * 24 * 30
+	defaultSlotsGenerateInterval = time.Hour
+
+	defaultWaitlistInterval = time.Minute
+
+	defaultSlotHoldTTL           = time.Minute * 5
+	defaultSlotHoldSweepInterval = time.Minute
+
+	defaultBlobStore = "fs"
+	defaultBlobDir   = ".data/blobs"
+
+	defaultBlobSweepInterval = time.Hour
+
+	defaultPasswordMinLength  = 8
+	defaultPasswordMaxLength  = 128
+	defaultPasswordMinClasses = 2
+
+	// Memory in KiB
+	defaultPasswordArgon2Memory     = 64 * 1024
+	defaultPasswordArgon2Iterations = 3
+	defaultPasswordArgon2Threads    = 4
+	defaultPasswordArgon2SaltLength = 16
+	defaultPasswordArgon2KeyLength  = 32
 )
 
 // Init initializes the default values for the config and various other viper settings.
@@ -37,4 +137,51 @@ func Init() {
 	viper.SetDefault(GRPCAddr, defaultGRPCAddr)
 	viper.SetDefault(HTTPAddr, defaultHTTPAddr)
 	viper.SetDefault(JWTPath, defaultJWTPath)
+	viper.SetDefault(JWTReloadInterval, defaultJWTReloadInterval)
+	viper.SetDefault(AdminCookieSecure, defaultAdminCookieSecure)
+	viper.SetDefault(AdminCookieSameSite, defaultAdminCookieSameSite)
+	viper.SetDefault(AdminSessionIdleTimeout, defaultAdminSessionIdleTimeout)
+	viper.SetDefault(AdminSessionMaxAge, defaultAdminSessionMaxAge)
+	viper.SetDefault(MailSender, defaultMailSender)
+	viper.SetDefault(MailDir, defaultMailDir)
+	viper.SetDefault(OIDCName, defaultOIDCName)
+	viper.SetDefault(OIDCScopes, defaultOIDCScopes)
+	viper.SetDefault(CacheSize, defaultCacheSize)
+	viper.SetDefault(CacheTTL, defaultCacheTTL)
+	viper.SetDefault(SlotsHorizon, defaultSlotsHorizon)
+	viper.SetDefault(SlotsGenerateInterval, defaultSlotsGenerateInterval)
+	viper.SetDefault(WaitlistInterval, defaultWaitlistInterval)
+	viper.SetDefault(SlotHoldTTL, defaultSlotHoldTTL)
+	viper.SetDefault(SlotHoldSweepInterval, defaultSlotHoldSweepInterval)
+	viper.SetDefault(BlobStore, defaultBlobStore)
+	viper.SetDefault(BlobDir, defaultBlobDir)
+	viper.SetDefault(BlobSweepInterval, defaultBlobSweepInterval)
+	viper.SetDefault(PasswordMinLength, defaultPasswordMinLength)
+	viper.SetDefault(PasswordMaxLength, defaultPasswordMaxLength)
+	viper.SetDefault(PasswordMinClasses, defaultPasswordMinClasses)
+	viper.SetDefault(PasswordArgon2Memory, defaultPasswordArgon2Memory)
+	viper.SetDefault(PasswordArgon2Iterations, defaultPasswordArgon2Iterations)
+	viper.SetDefault(PasswordArgon2Threads, defaultPasswordArgon2Threads)
+	viper.SetDefault(PasswordArgon2SaltLength, defaultPasswordArgon2SaltLength)
+	viper.SetDefault(PasswordArgon2KeyLength, defaultPasswordArgon2KeyLength)
+}
+
+// intervalKeys are the keys of the intervals of the background jobs, which can't tick at non-positive intervals.
+var intervalKeys = []string{
+	JWTReloadInterval,
+	SlotsGenerateInterval,
+	WaitlistInterval,
+	SlotHoldSweepInterval,
+	BlobSweepInterval,
+}
+
+// Validate checks the config values which would otherwise only fail once they are used, such as the intervals of the background jobs.
+func Validate() error {
+	for _, key := range intervalKeys {
+		if interval := viper.GetDuration(key); interval <= 0 {
+			return fmt.Errorf("%s must be a positive duration, got %q", key, viper.GetString(key))
+		}
+	}
+
+	return nil
 }
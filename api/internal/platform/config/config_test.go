@@ -0,0 +1,27 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestValidateIntervals(t *testing.T) {
+	Init()
+	t.Cleanup(viper.Reset)
+
+	if err := Validate(); err != nil {
+		t.Fatalf("default config is invalid: %v", err)
+	}
+
+	for _, key := range intervalKeys {
+		for _, value := range []string{"0s", "-1m", "invalid"} {
+			viper.Set(key, value)
+			if err := Validate(); err == nil {
+				t.Errorf("%s = %q is accepted", key, value)
+			}
+		}
+
+		viper.Set(key, "1m")
+	}
+}
@@ -0,0 +1,156 @@
+package slots
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"ldt-hack/api/internal/storage"
+
+	"github.com/samber/lo"
+	"golang.org/x/exp/slog"
+)
+
+const timeLayout = "15:04"
+
+// Generator materializes the consultation slots of the authorities from their slot templates
+// for a rolling horizon, so that the slots can be booked like the ones uploaded via spreadsheets.
+type Generator struct {
+	logger  *slog.Logger
+	db      *storage.Database
+	horizon time.Duration
+}
+
+// NewGenerator creates a new generator which keeps the slots generated up to the horizon from the current time.
+func NewGenerator(logger *slog.Logger, db *storage.Database, horizon time.Duration) *Generator {
+	return &Generator{
+		logger:  logger.With("component", "slots"),
+		db:      db,
+		horizon: horizon,
+	}
+}
+
+// Run generates the slots of all of the authorities immediately and then at the interval until the context is canceled.
+func (g *Generator) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := g.GenerateAll(ctx); err != nil {
+			g.logger.Error("failed to generate consultation slots", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// GenerateAll generates the slots of all of the authorities which have slot templates. A failure for one authority
+// doesn't stop the generation for the others, the errors of all of the failed authorities are returned joined.
+func (g *Generator) GenerateAll(ctx context.Context) error {
+	authorityIDs, err := g.db.ListSlotTemplateAuthorities(ctx)
+	if err != nil {
+		return fmt.Errorf("listing authorities with slot templates: %w", err)
+	}
+
+	var errs []error
+	for _, authorityID := range authorityIDs {
+		if err := g.Generate(ctx, authorityID); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Generate generates the slots of the authority's templates up to the horizon. Previously generated slots
+// which no longer match the templates, for example, due to new exceptions, are deleted unless they have been booked.
+func (g *Generator) Generate(ctx context.Context, authorityID int64) error {
+	templates, exceptions, err := g.db.GetAuthoritySlotSchedule(ctx, authorityID)
+	if err != nil {
+		return fmt.Errorf("getting slot schedule of authority %d: %w", authorityID, err)
+	}
+
+	from := time.Now().UTC()
+	to := from.Add(g.horizon)
+	slots := Expand(templates, exceptions, from, to)
+
+	if err := g.db.SyncTemplateSlots(ctx, authorityID, slots, from, to); err != nil {
+		return fmt.Errorf("syncing generated slots of authority %d: %w", authorityID, err)
+	}
+
+	g.logger.Debug("generated consultation slots", "authority_id", authorityID, "slots", len(slots))
+	return nil
+}
+
+// Expand materializes the slots of the templates starting in the specified period, skipping the dates of the exceptions.
+// Templates are expanded in UTC, which is how the times of the slots uploaded via spreadsheets are specified.
+func Expand(templates []storage.SlotTemplate, exceptions []storage.SlotException, from, to time.Time,
+) []storage.ConsultationSlot {
+	skippedDates := lo.SliceToMap(exceptions, func(e storage.SlotException) (string, struct{}) {
+		return e.Date.Format(time.DateOnly), struct{}{}
+	})
+
+	var slots []storage.ConsultationSlot
+	from, to = from.UTC(), to.UTC()
+
+	for day := from.Truncate(time.Hour * 24); day.Before(to); day = day.AddDate(0, 0, 1) {
+		if _, ok := skippedDates[day.Format(time.DateOnly)]; ok {
+			continue
+		}
+
+		for _, template := range templates {
+			if template.Weekday != isoWeekday(day) || day.Before(dateOf(template.ValidFrom)) ||
+				(template.ValidUntil != nil && day.After(dateOf(*template.ValidUntil))) {
+				continue
+			}
+
+			windowFrom, fromErr := time.Parse(timeLayout, template.FromTime)
+			windowTo, toErr := time.Parse(timeLayout, template.ToTime)
+			if fromErr != nil || toErr != nil || template.SlotMinutes <= 0 {
+				continue
+			}
+
+			length := time.Duration(template.SlotMinutes) * time.Minute
+			end := day.Add(sinceMidnight(windowTo))
+
+			for slotFrom := day.Add(sinceMidnight(windowFrom)); !slotFrom.Add(length).After(end); slotFrom = slotFrom.Add(length) {
+				if !slotFrom.After(from) || !slotFrom.Before(to) {
+					continue
+				}
+
+				templateID := template.ID
+				slots = append(slots, storage.ConsultationSlot{
+					AuthorityID: template.AuthorityID,
+					FromTime:    slotFrom,
+					ToTime:      slotFrom.Add(length),
+					TemplateID:  &templateID,
+				})
+			}
+		}
+	}
+
+	return slots
+}
+
+// isoWeekday returns the ISO day of the week, in which Monday is 1 and Sunday is 7.
+func isoWeekday(t time.Time) int {
+	if t.Weekday() == time.Sunday {
+		return 7
+	}
+
+	return int(t.Weekday())
+}
+
+// dateOf returns the date in UTC, since dates scanned from the database might have a different location.
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func sinceMidnight(t time.Time) time.Duration {
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+}
@@ -0,0 +1,177 @@
+package slots
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"ldt-hack/api/internal/storage"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func at(day time.Time, hour, minute int) time.Time {
+	return day.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+}
+
+func TestExpand(t *testing.T) {
+	// 2024-01-01 is a Monday and 2024-01-07 is a Sunday
+	monday, sunday, nextMonday := date(2024, 1, 1), date(2024, 1, 7), date(2024, 1, 8)
+	week := [2]time.Time{monday, nextMonday}
+
+	template := func(weekday int, fromTime, toTime string, slotMinutes int) storage.SlotTemplate {
+		return storage.SlotTemplate{
+			ID:          10,
+			AuthorityID: 1,
+			Weekday:     weekday,
+			FromTime:    fromTime,
+			ToTime:      toTime,
+			SlotMinutes: slotMinutes,
+			ValidFrom:   date(2023, 1, 1),
+		}
+	}
+
+	validFrom := template(1, "09:00", "10:00", 60)
+	validFrom.ValidFrom = nextMonday
+
+	validUntil := template(1, "09:00", "10:00", 60)
+	validUntil.ValidUntil = &monday
+
+	// Dates scanned from the database can be in the local time zone
+	localValidFrom := template(1, "09:00", "10:00", 60)
+	localValidFrom.ValidFrom = time.Date(2024, 1, 8, 0, 0, 0, 0, time.FixedZone("MSK", 3*60*60))
+
+	tests := []struct {
+		name       string
+		templates  []storage.SlotTemplate
+		exceptions []storage.SlotException
+		period     [2]time.Time
+		want       []time.Time
+	}{
+		{
+			name:      "monday window",
+			templates: []storage.SlotTemplate{template(1, "09:00", "11:00", 60)},
+			period:    week,
+			want:      []time.Time{at(monday, 9, 0), at(monday, 10, 0)},
+		},
+		{
+			name:      "sunday is weekday 7",
+			templates: []storage.SlotTemplate{template(7, "09:00", "10:00", 60)},
+			period:    week,
+			want:      []time.Time{at(sunday, 9, 0)},
+		},
+		{
+			name:      "weekday 0 matches nothing",
+			templates: []storage.SlotTemplate{template(0, "09:00", "10:00", 60)},
+			period:    week,
+		},
+		{
+			name:      "window not a multiple of slot length",
+			templates: []storage.SlotTemplate{template(1, "09:00", "10:45", 30)},
+			period:    week,
+			want:      []time.Time{at(monday, 9, 0), at(monday, 9, 30), at(monday, 10, 0)},
+		},
+		{
+			name:      "window shorter than slot",
+			templates: []storage.SlotTemplate{template(1, "09:00", "09:45", 60)},
+			period:    week,
+		},
+		{
+			name:       "exception",
+			templates:  []storage.SlotTemplate{template(1, "09:00", "10:00", 60), template(2, "09:00", "10:00", 60)},
+			exceptions: []storage.SlotException{{AuthorityID: 1, Date: monday}},
+			period:     week,
+			want:       []time.Time{at(date(2024, 1, 2), 9, 0)},
+		},
+		{
+			name:       "exception on another date",
+			templates:  []storage.SlotTemplate{template(1, "09:00", "10:00", 60)},
+			exceptions: []storage.SlotException{{AuthorityID: 1, Date: sunday}},
+			period:     week,
+			want:       []time.Time{at(monday, 9, 0)},
+		},
+		{
+			name:      "valid from",
+			templates: []storage.SlotTemplate{validFrom},
+			period:    [2]time.Time{monday, date(2024, 1, 15)},
+			want:      []time.Time{at(nextMonday, 9, 0)},
+		},
+		{
+			name:      "valid from in another location",
+			templates: []storage.SlotTemplate{localValidFrom},
+			period:    [2]time.Time{monday, date(2024, 1, 15)},
+			want:      []time.Time{at(nextMonday, 9, 0)},
+		},
+		{
+			name:      "valid until is inclusive",
+			templates: []storage.SlotTemplate{validUntil},
+			period:    [2]time.Time{monday, date(2024, 1, 15)},
+			want:      []time.Time{at(monday, 9, 0)},
+		},
+		{
+			name:      "slot starting at from is skipped",
+			templates: []storage.SlotTemplate{template(1, "09:00", "11:00", 60)},
+			period:    [2]time.Time{at(monday, 9, 0), nextMonday},
+			want:      []time.Time{at(monday, 10, 0)},
+		},
+		{
+			name:      "slot started before from is skipped",
+			templates: []storage.SlotTemplate{template(1, "09:00", "11:00", 60)},
+			period:    [2]time.Time{at(monday, 9, 30), nextMonday},
+			want:      []time.Time{at(monday, 10, 0)},
+		},
+		{
+			name:      "slot starting at to is skipped",
+			templates: []storage.SlotTemplate{template(1, "09:00", "11:00", 60)},
+			period:    [2]time.Time{monday, at(monday, 10, 0)},
+			want:      []time.Time{at(monday, 9, 0)},
+		},
+		{
+			name:      "to before the first template day",
+			templates: []storage.SlotTemplate{template(2, "09:00", "10:00", 60)},
+			period:    [2]time.Time{monday, date(2024, 1, 2)},
+		},
+		{
+			name:      "period in another location",
+			templates: []storage.SlotTemplate{template(1, "09:00", "11:00", 60)},
+			period: [2]time.Time{
+				time.Date(2024, 1, 1, 12, 30, 0, 0, time.FixedZone("MSK", 3*60*60)),
+				time.Date(2024, 1, 1, 13, 30, 0, 0, time.FixedZone("MSK", 3*60*60)),
+			},
+			want: []time.Time{at(monday, 10, 0)},
+		},
+		{
+			name: "invalid templates are skipped",
+			templates: []storage.SlotTemplate{
+				template(1, "9am", "10:00", 60),
+				template(1, "09:00", "", 60),
+				template(1, "09:00", "10:00", 0),
+			},
+			period: week,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slots := Expand(tt.templates, tt.exceptions, tt.period[0], tt.period[1])
+
+			var got []time.Time
+			for _, slot := range slots {
+				got = append(got, slot.FromTime)
+
+				length := time.Duration(tt.templates[0].SlotMinutes) * time.Minute
+				if slot.ToTime.Sub(slot.FromTime) != length {
+					t.Errorf("slot %v lasts %v, want %v", slot.FromTime, slot.ToTime.Sub(slot.FromTime), length)
+				} else if slot.AuthorityID != 1 || slot.TemplateID == nil || *slot.TemplateID != 10 {
+					t.Errorf("slot %v has authority %d and template %v", slot.FromTime, slot.AuthorityID, slot.TemplateID)
+				}
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("slots start at %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,17 +4,21 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
+	"github.com/samber/lo"
 	"github.com/uptrace/bun"
 )
 
 type Account struct {
 	bun.BaseModel `bun:"table:account,alias:a"`
 
-	ID           int64       `bun:",pk,type:bigserial,autoincrement"`
-	Type         AccountType `bun:"type:account_type,notnull"`
-	Email        string      `bun:"type:text,notnull"`
-	PasswordHash []byte      `bun:"type:bytea,notnull"`
+	ID              int64       `bun:",pk,type:bigserial,autoincrement"`
+	Type            AccountType `bun:"type:account_type,notnull"`
+	Role            AccountRole `bun:"type:account_role,notnull"`
+	Email           string      `bun:"type:text,notnull"`
+	PasswordHash    []byte      `bun:"type:bytea,notnull"`
+	EmailVerifiedAt *time.Time  `bun:"type:timestamptz"`
 }
 
 // CheckAccountExists returns true if an account with the given ID and type exists.
@@ -39,11 +43,108 @@ func (db *Database) GetAccount(ctx context.Context, email string, accountType Ac
 	return account, nil
 }
 
+// GetAccountByID returns the account with the specified ID.
+func (db *Database) GetAccountByID(ctx context.Context, accountID int64) (Account, error) {
+	var account Account
+	if err := db.bun.NewSelect().Model(&account).Where("id = ?", accountID).Scan(ctx); err != nil {
+		return Account{}, wrapError("GetAccountByID", err)
+	}
+
+	return account, nil
+}
+
+// CheckEmailExists returns true if an account of any type with the given email exists.
+func (db *Database) CheckEmailExists(ctx context.Context, email string) (bool, error) {
+	exists, err := db.bun.NewSelect().Model((*Account)(nil)).Where("email = ?", email).Exists(ctx)
+	if err != nil {
+		return false, wrapError("CheckEmailExists", err)
+	}
+
+	return exists, nil
+}
+
+// UpdatePassword sets a new password hash for the account and revokes all of its sessions except for the specified one.
+func (db *Database) UpdatePassword(ctx context.Context, accountID int64, passwordHash []byte, keepSessionID string) error {
+	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewUpdate().Model((*Account)(nil)).
+			Set("password_hash = ?", passwordHash).
+			Where("id = ?", accountID).
+			Returning("").Exec(ctx)
+		if err != nil {
+			return wrapError("UpdatePassword.Account", err)
+		}
+
+		return revokeOtherSessionsTx(ctx, tx, accountID, keepSessionID)
+	})
+	if err != nil {
+		return fmt.Errorf("executing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// RehashPassword replaces the password hash of the account with an upgraded hash of the same password.
+// The hash is only replaced if the password hasn't been changed concurrently, and no sessions are revoked.
+func (db *Database) RehashPassword(ctx context.Context, accountID int64, oldPasswordHash, newPasswordHash []byte) error {
+	_, err := db.bun.NewUpdate().Model((*Account)(nil)).
+		Set("password_hash = ?", newPasswordHash).
+		Where("id = ?", accountID).
+		Where("password_hash = ?", oldPasswordHash).
+		Returning("").Exec(ctx)
+	if err != nil {
+		return wrapError("RehashPassword", err)
+	}
+
+	return nil
+}
+
+// UpdateAccountRole sets the role of the account with the specified email, returning ErrNotFound
+// if no such account exists or if the role can't be assigned to an account of its type.
+func (db *Database) UpdateAccountRole(ctx context.Context, email string, role AccountRole) error {
+	var accountTypes []AccountType
+	for accountType, roles := range AccountTypeRoles {
+		if lo.Contains(roles, role) {
+			accountTypes = append(accountTypes, accountType)
+		}
+	}
+
+	if len(accountTypes) == 0 {
+		return ErrNotFound
+	}
+
+	result, err := db.bun.NewUpdate().Model((*Account)(nil)).
+		Set("role = ?", role).
+		Where("email = ?", email).
+		Where("type in (?)", bun.In(accountTypes)).
+		Exec(ctx)
+	if err != nil {
+		return wrapError("UpdateAccountRole", err)
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return wrapError("UpdateAccountRole.RowsAffected", err)
+	} else if affected < 1 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
 // DeleteAccount deletes the account and removes the reference to it from other tables.
 // Information about the actual users isn't deleted so that inspectors/users can view info
 // about the previous consultations even if the user is now gone.
+// All of the account's sessions are deleted along with it, which revokes all of its tokens.
 func (db *Database) DeleteAccount(ctx context.Context, accountID int64) error {
 	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
+		// The waitlist would otherwise keep booking appointments for the user who is gone
+		if _, err := deleteAccountWaitlistEntries(tx, accountID).Exec(ctx); err != nil {
+			return wrapError("DeleteAccount.WaitlistEntries", err)
+		}
+
+		if _, err := deleteAccountSlotHolds(tx, accountID).Exec(ctx); err != nil {
+			return wrapError("DeleteAccount.SlotHolds", err)
+		}
+
 		_, err := tx.NewUpdate().Model((*BusinessUser)(nil)).
 			Where("account_id  = ?", accountID).Set("account_id = ?", nil).Returning("").Exec(ctx)
 		if err != nil {
@@ -0,0 +1,221 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type AdminUser struct {
+	bun.BaseModel `bun:"table:admin_user,alias:au"`
+
+	ID           int64      `bun:",pk,type:bigserial,autoincrement"`
+	Username     string     `bun:"type:text,notnull"`
+	PasswordHash []byte     `bun:"type:bytea,notnull"`
+	Role         AdminRole  `bun:"type:admin_role,notnull"`
+	Disabled     bool       `bun:"type:boolean,notnull,default:false"`
+	CreatedAt    time.Time  `bun:"type:timestamptz,default:now()"`
+	LastLoginAt  *time.Time `bun:"type:timestamptz"`
+	// Sessions issued before this time have been revoked
+	SessionsValidAfter *time.Time `bun:"type:timestamptz"`
+}
+
+// RevokedAdminSession is an admin session which has been signed out before its expiry.
+type RevokedAdminSession struct {
+	bun.BaseModel `bun:"table:revoked_admin_session,alias:ras"`
+
+	SessionID   string    `bun:",pk,type:text"`
+	AdminUserID int64     `bun:"type:bigint"`
+	ExpiresAt   time.Time `bun:"type:timestamptz,notnull"`
+}
+
+// AdminUserUpdate contains the fields of an admin which should be updated, nil fields are left unchanged.
+type AdminUserUpdate struct {
+	PasswordHash []byte
+	Role         *AdminRole
+	Disabled     *bool
+}
+
+// SeedAdminUser creates the admin only if no other admins exist, returning true if it has been created.
+func (db *Database) SeedAdminUser(ctx context.Context, user AdminUser) (bool, error) {
+	var created bool
+
+	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
+		// Lock the table so that concurrently starting instances don't both seed an admin
+		if _, err := tx.ExecContext(ctx, "lock table admin_user in share row exclusive mode"); err != nil {
+			return wrapError("SeedAdminUser.Lock", err)
+		}
+
+		exists, err := tx.NewSelect().Model((*AdminUser)(nil)).Exists(ctx)
+		if err != nil {
+			return wrapError("SeedAdminUser.Exists", err)
+		} else if exists {
+			return nil
+		}
+
+		if _, err := tx.NewInsert().Model(&user).Returning("").Exec(ctx); err != nil {
+			return wrapError("SeedAdminUser.Insert", err)
+		}
+
+		created = true
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("executing transaction: %w", err)
+	}
+
+	return created, nil
+}
+
+// CreateAdminUser creates a new admin, returning ErrAlreadyExists if the username is taken.
+func (db *Database) CreateAdminUser(ctx context.Context, user AdminUser) (int64, error) {
+	if _, err := db.bun.NewInsert().Model(&user).Returning("id").Exec(ctx); err != nil {
+		return 0, wrapError("CreateAdminUser", err)
+	}
+
+	return user.ID, nil
+}
+
+// GetAdminUser gets the admin by ID.
+func (db *Database) GetAdminUser(ctx context.Context, id int64) (AdminUser, error) {
+	var user AdminUser
+	if err := db.bun.NewSelect().Model(&user).Where("id = ?", id).Scan(ctx); err != nil {
+		return AdminUser{}, wrapError("GetAdminUser", err)
+	}
+
+	return user, nil
+}
+
+// GetAdminUserByUsername gets the admin by username.
+func (db *Database) GetAdminUserByUsername(ctx context.Context, username string) (AdminUser, error) {
+	var user AdminUser
+	if err := db.bun.NewSelect().Model(&user).Where("username = ?", username).Scan(ctx); err != nil {
+		return AdminUser{}, wrapError("GetAdminUserByUsername", err)
+	}
+
+	return user, nil
+}
+
+// ListAdminUsers lists all of the admins.
+func (db *Database) ListAdminUsers(ctx context.Context) ([]AdminUser, error) {
+	var users []AdminUser
+	if err := db.bun.NewSelect().Model(&users).Order("id").Scan(ctx); err != nil {
+		return nil, wrapError("ListAdminUsers", err)
+	}
+
+	return users, nil
+}
+
+// UpdateAdminUser updates the specified fields of the admin, returning ErrNotFound if it doesn't exist.
+func (db *Database) UpdateAdminUser(ctx context.Context, id int64, update AdminUserUpdate) error {
+	query := db.bun.NewUpdate().Model((*AdminUser)(nil)).Where("id = ?", id)
+	if update.PasswordHash != nil {
+		// The sessions started using the old password are signed out
+		query = query.Set("password_hash = ?", update.PasswordHash).Set("sessions_valid_after = now()")
+	}
+
+	if update.Role != nil {
+		query = query.Set("role = ?", *update.Role)
+	}
+
+	if update.Disabled != nil {
+		query = query.Set("disabled = ?", *update.Disabled)
+	}
+
+	// Nothing to update, but the admin must still exist
+	if update.PasswordHash == nil && update.Role == nil && update.Disabled == nil {
+		_, err := db.GetAdminUser(ctx, id)
+		return err
+	}
+
+	result, err := query.Returning("").Exec(ctx)
+	if err != nil {
+		return wrapError("UpdateAdminUser", err)
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return wrapError("UpdateAdminUser.RowsAffected", err)
+	} else if affected < 1 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// RecordAdminLogin updates the admin's last login time and upgrades the password hash if a new one is specified.
+func (db *Database) RecordAdminLogin(ctx context.Context, id int64, newPasswordHash []byte) error {
+	query := db.bun.NewUpdate().Model((*AdminUser)(nil)).
+		Set("last_login_at = now()").
+		Where("id = ?", id)
+	if newPasswordHash != nil {
+		query = query.Set("password_hash = ?", newPasswordHash)
+	}
+
+	if _, err := query.Returning("").Exec(ctx); err != nil {
+		return wrapError("RecordAdminLogin", err)
+	}
+
+	return nil
+}
+
+// RevokeAdminSession revokes the admin's session with the ID until it expires.
+// The revocations of the sessions which have already expired are deleted along the way.
+func (db *Database) RevokeAdminSession(ctx context.Context, adminID int64, sessionID string, expiresAt time.Time) error {
+	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewDelete().Model((*RevokedAdminSession)(nil)).
+			Where("expires_at <= now()").
+			Returning("").Exec(ctx)
+		if err != nil {
+			return wrapError("RevokeAdminSession.DeleteExpired", err)
+		}
+
+		_, err = tx.NewInsert().Model(&RevokedAdminSession{
+			SessionID:   sessionID,
+			AdminUserID: adminID,
+			ExpiresAt:   expiresAt,
+		}).On("conflict (session_id) do nothing").Returning("").Exec(ctx)
+		if err != nil {
+			return wrapError("RevokeAdminSession.Insert", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("executing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// IsAdminSessionRevoked checks whether the admin session with the ID has been revoked.
+func (db *Database) IsAdminSessionRevoked(ctx context.Context, sessionID string) (bool, error) {
+	revoked, err := db.bun.NewSelect().Model((*RevokedAdminSession)(nil)).
+		Where("session_id = ?", sessionID).
+		Exists(ctx)
+	if err != nil {
+		return false, wrapError("IsAdminSessionRevoked", err)
+	}
+
+	return revoked, nil
+}
+
+// DeleteAdminUser deletes the admin, returning ErrNotFound if it doesn't exist.
+func (db *Database) DeleteAdminUser(ctx context.Context, id int64) error {
+	result, err := db.bun.NewDelete().Model((*AdminUser)(nil)).
+		Where("id = ?", id).
+		Returning("").Exec(ctx)
+	if err != nil {
+		return wrapError("DeleteAdminUser", err)
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return wrapError("DeleteAdminUser.RowsAffected", err)
+	} else if affected < 1 {
+		return ErrNotFound
+	}
+
+	return nil
+}
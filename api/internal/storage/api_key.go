@@ -0,0 +1,111 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type APIKey struct {
+	bun.BaseModel `bun:"table:api_key,alias:ak"`
+
+	ID          int64       `bun:",pk,type:bigserial,autoincrement"`
+	AuthorityID int64       `bun:"type:bigint,notnull"`
+	Name        string      `bun:"type:text,notnull"`
+	KeyHash     []byte      `bun:"type:bytea,notnull"`
+	Scope       APIKeyScope `bun:"type:api_key_scope,notnull"`
+	CreatedAt   time.Time   `bun:"type:timestamptz,default:now()"`
+	ExpiresAt   *time.Time  `bun:"type:timestamptz"`
+	LastUsedAt  *time.Time  `bun:"type:timestamptz"`
+	RevokedAt   *time.Time  `bun:"type:timestamptz"`
+}
+
+// CreateAPIKey creates a new API key for the authority, returning ErrNotFound if the authority doesn't exist.
+func (db *Database) CreateAPIKey(ctx context.Context, key APIKey) (int64, error) {
+	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
+		exists, err := tx.NewSelect().Model((*Authority)(nil)).Where("id = ?", key.AuthorityID).Exists(ctx)
+		if err != nil {
+			return wrapError("CreateAPIKey.Exists", err)
+		} else if !exists {
+			return ErrNotFound
+		}
+
+		if _, err := tx.NewInsert().Model(&key).Returning("id").Exec(ctx); err != nil {
+			return wrapError("CreateAPIKey", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("executing transaction: %w", err)
+	}
+
+	return key.ID, nil
+}
+
+// GetActiveAPIKey gets the API key by its hash, returning ErrNotFound if it doesn't exist, has expired or has been revoked.
+func (db *Database) GetActiveAPIKey(ctx context.Context, keyHash []byte) (APIKey, error) {
+	var key APIKey
+
+	err := db.bun.NewSelect().Model(&key).
+		Where("key_hash = ?", keyHash).
+		Where("revoked_at is null").
+		Where("expires_at is null or expires_at > now()").
+		Scan(ctx)
+	if err != nil {
+		return APIKey{}, wrapError("GetActiveAPIKey", err)
+	}
+
+	return key, nil
+}
+
+// TouchAPIKey updates the last used time of the API key.
+func (db *Database) TouchAPIKey(ctx context.Context, id int64) error {
+	_, err := db.bun.NewUpdate().Model((*APIKey)(nil)).
+		Set("last_used_at = now()").
+		Where("id = ?", id).
+		Returning("").Exec(ctx)
+	if err != nil {
+		return wrapError("TouchAPIKey", err)
+	}
+
+	return nil
+}
+
+// ListAuthorityAPIKeys lists all of the API keys of the authority, including the expired and revoked ones.
+func (db *Database) ListAuthorityAPIKeys(ctx context.Context, authorityID int64) ([]APIKey, error) {
+	var keys []APIKey
+
+	err := db.bun.NewSelect().Model(&keys).
+		Where("authority_id = ?", authorityID).
+		Order("id").
+		Scan(ctx)
+	if err != nil {
+		return nil, wrapError("ListAuthorityAPIKeys", err)
+	}
+
+	return keys, nil
+}
+
+// RevokeAPIKey revokes the API key, returning ErrNotFound if it doesn't exist or has already been revoked.
+func (db *Database) RevokeAPIKey(ctx context.Context, id int64) error {
+	result, err := db.bun.NewUpdate().Model((*APIKey)(nil)).
+		Set("revoked_at = now()").
+		Where("id = ?", id).
+		Where("revoked_at is null").
+		Returning("").Exec(ctx)
+	if err != nil {
+		return wrapError("RevokeAPIKey", err)
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return wrapError("RevokeAPIKey.RowsAffected", err)
+	} else if affected < 1 {
+		return ErrNotFound
+	}
+
+	return nil
+}
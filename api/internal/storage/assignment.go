@@ -0,0 +1,107 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/uptrace/bun"
+)
+
+// Period before and after a slot in which the appointments of the inspectors are counted as their load
+const AssignmentLoadWindow = time.Hour * 24 * 7
+
+// AssignmentCandidate is an inspector available for a new appointment
+// along with the statistics used by the assignment strategies to choose between the candidates.
+type AssignmentCandidate struct {
+	Inspector InspectorUser
+	// Number of active appointments of the inspector within the load window around the slot
+	Load int
+	// Time of the inspector's latest assignment, nil if they have never been assigned
+	LastAssignedAt *time.Time
+	// Start of the latest appointment of the inspector with the business user, nil if there were none
+	LastServedAt *time.Time
+}
+
+// Assignment is the choice of an inspector for an appointment along with an explanation for auditing.
+type Assignment struct {
+	Inspector InspectorUser
+	Strategy  AssignmentStrategy
+	Reason    string
+}
+
+// Assigner chooses one of the non-empty list of candidates for an appointment using the strategy.
+type Assigner func(strategy AssignmentStrategy, candidates []AssignmentCandidate) Assignment
+
+// assignmentStats are the statistics of an inspector's appointments from which the assignment candidates are constructed.
+type assignmentStats struct {
+	InspectorUserID int64
+	Load            int
+	LastAssignedAt  *time.Time
+	LastServedAt    *time.Time
+}
+
+// SetAuthorityAssignmentStrategy sets the strategy used for choosing inspectors for the authority's appointments.
+// ErrNotFound is returned if the authority doesn't exist.
+func (db *Database) SetAuthorityAssignmentStrategy(ctx context.Context, authorityID int64, strategy AssignmentStrategy) error {
+	result, err := db.bun.NewUpdate().Model((*Authority)(nil)).
+		Set("assignment_strategy = ?", strategy).
+		Where("id = ?", authorityID).
+		Returning("").Exec(ctx)
+	if err != nil {
+		return wrapError("SetAuthorityAssignmentStrategy", err)
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return wrapError("SetAuthorityAssignmentStrategy.RowsAffected", err)
+	} else if affected < 1 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// assignInspectorTx chooses one of the available inspectors for an appointment in the slot
+// using the strategy of the slot's authority.
+func assignInspectorTx(ctx context.Context, tx bun.Tx, assign Assigner,
+	inspectors []InspectorUser, slotID, businessUserID int64,
+) (Assignment, error) {
+	var slot ConsultationSlot
+	if err := tx.NewSelect().Model(&slot).Relation("Authority").Where("acs.id = ?", slotID).Scan(ctx); err != nil {
+		return Assignment{}, wrapError("AssignInspector.Slot", err)
+	}
+
+	var stats []assignmentStats
+
+	err := tx.NewSelect().Model((*ConsultationAppointment)(nil)).
+		ColumnExpr("ca.inspector_user_id").
+		ColumnExpr("count(*) filter (where ca.canceled_at is null and slot.from_time between ? and ?) as load",
+			slot.FromTime.Add(-AssignmentLoadWindow), slot.FromTime.Add(AssignmentLoadWindow)).
+		ColumnExpr("max(ca.assigned_at) as last_assigned_at").
+		ColumnExpr("max(slot.from_time) filter (where ca.business_user_id = ? and ca.canceled_at is null) as last_served_at", businessUserID).
+		Join("join authority_consultation_slots slot on slot.id = ca.slot_id").
+		Where("ca.inspector_user_id in (?)", bun.In(lo.Map(inspectors, func(i InspectorUser, _ int) int64 {
+			return i.ID
+		}))).
+		Group("ca.inspector_user_id").
+		Scan(ctx, &stats)
+	if err != nil {
+		return Assignment{}, wrapError("AssignInspector.Stats", err)
+	}
+
+	statsByInspector := lo.KeyBy(stats, func(s assignmentStats) int64 {
+		return s.InspectorUserID
+	})
+
+	candidates := lo.Map(inspectors, func(i InspectorUser, _ int) AssignmentCandidate {
+		s := statsByInspector[i.ID]
+		return AssignmentCandidate{
+			Inspector:      i,
+			Load:           s.Load,
+			LastAssignedAt: s.LastAssignedAt,
+			LastServedAt:   s.LastServedAt,
+		}
+	})
+
+	return assign(slot.Authority.AssignmentStrategy, candidates), nil
+}
@@ -0,0 +1,65 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type ConsultationAttachment struct {
+	bun.BaseModel `bun:"table:consultation_attachment,alias:cat"`
+
+	ID            int64                    `bun:",pk,type:bigserial,autoincrement"`
+	AppointmentID string                   `bun:"type:uuid"`
+	Appointment   *ConsultationAppointment `bun:"rel:belongs-to,join:appointment_id=id"`
+	Name          string                   `bun:"type:text,notnull"`
+	ContentType   string                   `bun:"type:text,notnull"`
+	Size          int64                    `bun:"type:bigint,notnull"`
+	// Key under which the contents are kept in the blob store
+	BlobKey   string    `bun:"type:text,unique,notnull"`
+	CreatedAt time.Time `bun:"type:timestamptz,nullzero,default:now()"`
+}
+
+// GetConsultationAttachment returns the attachment along with its appointment's participants,
+// so that the access to it can be checked.
+func (db *Database) GetConsultationAttachment(ctx context.Context, attachmentID int64) (ConsultationAttachment, error) {
+	var attachment ConsultationAttachment
+
+	err := db.bun.NewSelect().Model(&attachment).
+		Relation("Appointment", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Column("id", "business_user_id", "inspector_user_id")
+		}).
+		Relation("Appointment.BusinessUser").
+		Relation("Appointment.InspectorUser").
+		Where("cat.id = ?", attachmentID).
+		Scan(ctx)
+	if err != nil {
+		return ConsultationAttachment{}, wrapError("GetConsultationAttachment", err)
+	}
+
+	return attachment, nil
+}
+
+// FilterAttachmentBlobKeys returns the blob keys out of the specified ones which belong to existing attachments.
+func (db *Database) FilterAttachmentBlobKeys(ctx context.Context, blobKeys []string) ([]string, error) {
+	var existing []string
+	if len(blobKeys) == 0 {
+		return existing, nil
+	}
+
+	err := db.bun.NewSelect().Model((*ConsultationAttachment)(nil)).
+		Column("blob_key").
+		Where("blob_key in (?)", bun.In(blobKeys)).
+		Scan(ctx, &existing)
+	if err != nil {
+		return nil, wrapError("FilterAttachmentBlobKeys", err)
+	}
+
+	return existing, nil
+}
+
+// orderAttachments keeps the attachments of the appointments in the order of uploading.
+func orderAttachments(q *bun.SelectQuery) *bun.SelectQuery {
+	return q.Order("cat.id")
+}
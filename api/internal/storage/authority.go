@@ -2,6 +2,8 @@ package storage
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
 
 	"github.com/samber/lo"
 	"github.com/uptrace/bun"
@@ -10,8 +12,11 @@ import (
 type Authority struct {
 	bun.BaseModel `bun:"table:authority"`
 
-	ID   int64  `bun:",pk,type:bigserial,autoincrement"`
-	Name string `bun:"type:text,notnull"`
+	ID               int64  `bun:",pk,type:bigserial,autoincrement"`
+	Name             string `bun:"type:text,notnull"`
+	RequireTwoFactor bool   `bun:"type:boolean,notnull,default:false"`
+	// Strategy used for choosing the inspectors for new appointments
+	AssignmentStrategy AssignmentStrategy `bun:"type:assignment_strategy,notnull,default:'random'"`
 }
 
 // CreateAuthoritiesTx creates authorities which don't exist yet and returns all of the authorities in the DB.
@@ -45,3 +50,53 @@ func (db *Database) ListAuthorities(ctx context.Context) ([]Authority, error) {
 
 	return authorities, nil
 }
+
+// SetAuthorityTwoFactorRequired sets whether two-factor authentication is required for the authority's inspectors.
+// When it becomes required, the sessions of inspectors without two-factor authentication are revoked,
+// so that they have to enroll during the next login. ErrNotFound is returned if the authority doesn't exist.
+func (db *Database) SetAuthorityTwoFactorRequired(ctx context.Context, authorityID int64, required bool) error {
+	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
+		result, err := tx.NewUpdate().Model((*Authority)(nil)).
+			Set("require_two_factor = ?", required).
+			Where("id = ?", authorityID).
+			Returning("").Exec(ctx)
+		if err != nil {
+			return wrapError("SetAuthorityTwoFactorRequired.Update", err)
+		}
+
+		if affected, err := result.RowsAffected(); err != nil {
+			return wrapError("SetAuthorityTwoFactorRequired.RowsAffected", err)
+		} else if affected < 1 {
+			return ErrNotFound
+		} else if !required {
+			return nil
+		}
+
+		var accountIDs []int64
+		err = tx.NewSelect().Model((*InspectorUser)(nil)).
+			Column("account_id").
+			Where("authority_id = ?", authorityID).
+			Where("account_id is not null").
+			Where("account_id not in (?)", tx.NewSelect().Model((*AccountTOTP)(nil)).
+				Column("account_id").
+				Where("enabled_at is not null"),
+			).
+			Scan(ctx, &accountIDs)
+		if err != nil {
+			return wrapError("SetAuthorityTwoFactorRequired.Select", err)
+		}
+
+		for _, accountID := range accountIDs {
+			if err := revokeSessionsTx(ctx, tx, accountID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("executing transaction: %w", err)
+	}
+
+	return nil
+}
@@ -27,6 +27,7 @@ type BusinessUser struct {
 func (db *Database) CreateBusinessUser(ctx context.Context, email string, passwordHash []byte, user BusinessUser) (int64, error) {
 	account := Account{
 		Type:         AccountTypeBusiness,
+		Role:         AccountRoleBusinessOwner,
 		Email:        email,
 		PasswordHash: passwordHash,
 	}
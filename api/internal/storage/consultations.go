@@ -4,14 +4,21 @@ import (
 	"context"
 	"database/sql"
 	"errors"
-	"math/rand"
 	"time"
 
+	"github.com/samber/lo"
 	"github.com/uptrace/bun"
 )
 
 var ErrConsultationSlotExhausted = errors.New("chosen consultation slot has already been taken")
 
+// joinInspectorSlotAppointments joins the appointments (ca) which occupy the inspector (iu) in the slot (acs),
+// so that the inspector is available only if none are joined. Besides the active appointments, these include
+// the ones which the inspector has declined, so that the slot isn't booked with them again right after that.
+// The appointments canceled by the business users or on behalf of the authority free up the inspector.
+const joinInspectorSlotAppointments = `left join consultation_appointment ca on ca.slot_id = acs.id and ca.inspector_user_id = iu.id
+	and (ca.canceled_at is null or ca.declined_by_inspector)`
+
 type ConsultationTopic struct {
 	bun.BaseModel `bun:"table:authority_consultation_topic"`
 
@@ -29,6 +36,8 @@ type ConsultationSlot struct {
 	Authority   Authority `bun:"rel:belongs-to,join:authority_id=id"`
 	FromTime    time.Time `bun:"type:timestamptz,notnull"`
 	ToTime      time.Time `bun:"type:timestamptz,notnull"`
+	// TemplateID is set for the slots generated from a slot template
+	TemplateID *int64 `bun:"type:bigint"`
 }
 
 type ConsultationAppointment struct {
@@ -44,6 +53,18 @@ type ConsultationAppointment struct {
 	InspectorUserID int64             `bun:"type:bigint"`
 	InspectorUser   InspectorUser     `bun:"rel:belongs-to,join:inspector_user_id=id"`
 	CanceledAt      *time.Time        `bun:"type:timestamptz"`
+	// Type of the user who canceled the appointment and their explanation, which is mandatory for inspectors
+	CanceledBy   AccountType `bun:"type:account_type,nullzero"`
+	CancelReason string      `bun:"type:text,nullzero"`
+	// Set if the appointment has been canceled by the assigned inspector themselves
+	DeclinedByInspector bool `bun:"type:boolean,notnull,default:false"`
+	// How and why the inspector has been chosen
+	AssignedAt         time.Time          `bun:"type:timestamptz,nullzero,default:now()"`
+	AssignmentStrategy AssignmentStrategy `bun:"type:assignment_strategy,nullzero"`
+	AssignmentReason   string             `bun:"type:text,nullzero"`
+	// Details provided by the business user for the inspector
+	Question    string                   `bun:"type:text,nullzero"`
+	Attachments []ConsultationAttachment `bun:"rel:has-many,join:id=appointment_id"`
 }
 
 // CreateTopicsTx creates topics which don't exist yet and returns all of the topics in the DB.
@@ -65,20 +86,173 @@ func (db *Database) CreateSlotsTx(ctx context.Context, tx bun.Tx, slots []Consul
 	return nil
 }
 
-// CreateConsultationAppointment creates a new consultation appointment for the specified business user
-// with a random available inspector of the specified authority.
-func (db *Database) CreateConsultationAppointment(ctx context.Context, topicID, slotID, businessUserID int64) (InspectorUser, error) {
-	var chosenInspector InspectorUser
+// CreateConsultationAppointment creates a new consultation appointment for the specified business user with one
+// of the available inspectors of the specified authority who are competent in the topic, chosen using the assigner.
+// If the user holds the slot with the token hash, the held inspector is kept as long as they're competent in the topic,
+// and the hold is released. Expired or unknown holds are ignored. The question and the attachments, whose contents
+// must already be in the blob store, are optional.
+func (db *Database) CreateConsultationAppointment(ctx context.Context, topicID, slotID, businessUserID int64,
+	holdTokenHash []byte, question string, attachments []ConsultationAttachment, assign Assigner,
+) (InspectorUser, error) {
+	var assignment Assignment
 
 	err := db.bun.RunInTx(ctx, &sql.TxOptions{
 		ReadOnly: false,
 	}, func(ctx context.Context, tx bun.Tx) error {
+		var hold SlotHold
+		if holdTokenHash != nil {
+			var err error
+			if hold, err = releaseSlotHoldTx(ctx, tx, holdTokenHash, slotID, businessUserID); err != nil && !errors.Is(err, ErrNotFound) {
+				return err
+			}
+		}
+
+		availableInspectors, err := listSlotInspectorsTx(ctx, tx, topicID, slotID)
+		if err != nil {
+			return err
+		} else if len(availableInspectors) == 0 {
+			return ErrConsultationSlotExhausted
+		}
+
+		if inspector, ok := lo.Find(availableInspectors, func(i InspectorUser) bool {
+			return i.ID == hold.InspectorUserID
+		}); ok {
+			assignment = Assignment{
+				Inspector: inspector,
+				Strategy:  hold.AssignmentStrategy,
+				Reason:    hold.AssignmentReason,
+			}
+		} else {
+			assignment, err = assignInspectorTx(ctx, tx, assign, availableInspectors, slotID, businessUserID)
+			if err != nil {
+				return err
+			}
+		}
+
+		appointment, err := insertConsultationAppointmentTx(ctx, tx, topicID, slotID, businessUserID, question, assignment)
+		if err != nil || len(attachments) == 0 {
+			return err
+		}
+
+		for i := range attachments {
+			attachments[i].AppointmentID = appointment.ID
+		}
+
+		if _, err := tx.NewInsert().Model(&attachments).Returning("").Exec(ctx); err != nil {
+			return wrapError("Attachments", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return InspectorUser{}, wrapError("CreateConsultationAppointment", err)
+	}
+
+	return assignment.Inspector, nil
+}
+
+// listSlotInspectorsTx lists the inspectors who are available in the slot and are competent in the topic.
+// Slots which have already started have no available inspectors, so that they can't be held or booked.
+func listSlotInspectorsTx(ctx context.Context, tx bun.Tx, topicID, slotID int64) ([]InspectorUser, error) {
+	var inspectors []InspectorUser
+
+	if err := selectSlotInspectors(tx, &inspectors, topicID, slotID).Scan(ctx); err != nil {
+		return nil, wrapError("Inspectors", err)
+	}
+
+	return inspectors, nil
+}
+
+func selectSlotInspectors(db bun.IDB, model any, topicID, slotID int64) *bun.SelectQuery {
+	return db.NewSelect().Model(model).
+		Join("join authority_consultation_slots acs on acs.authority_id = iu.authority_id").
+		Join(joinInspectorSlotAppointments).
+		Where("acs.id = ?", slotID).
+		Where("acs.from_time > now()").
+		Where("ca.id is null").
+		Where(inspectorOnDutyCondition).
+		Where(inspectorNotHeldCondition).
+		Apply(whereInspectorQualified(topicID))
+}
+
+// createConsultationAppointmentTx creates a new consultation appointment in the slot with one of the available inspectors.
+// ErrConsultationSlotExhausted is returned if there are none.
+func createConsultationAppointmentTx(ctx context.Context, tx bun.Tx, topicID, slotID, businessUserID int64, assign Assigner,
+) (ConsultationAppointment, Assignment, error) {
+	availableInspectors, err := listSlotInspectorsTx(ctx, tx, topicID, slotID)
+	if err != nil {
+		return ConsultationAppointment{}, Assignment{}, err
+	} else if len(availableInspectors) == 0 {
+		return ConsultationAppointment{}, Assignment{}, ErrConsultationSlotExhausted
+	}
+
+	assignment, err := assignInspectorTx(ctx, tx, assign, availableInspectors, slotID, businessUserID)
+	if err != nil {
+		return ConsultationAppointment{}, Assignment{}, err
+	}
+
+	appointment, err := insertConsultationAppointmentTx(ctx, tx, topicID, slotID, businessUserID, "", assignment)
+	if err != nil {
+		return ConsultationAppointment{}, Assignment{}, err
+	}
+
+	return appointment, assignment, nil
+}
+
+func insertConsultationAppointmentTx(ctx context.Context, tx bun.Tx, topicID, slotID, businessUserID int64, question string,
+	assignment Assignment,
+) (ConsultationAppointment, error) {
+	appointment := ConsultationAppointment{
+		TopicID:            topicID,
+		SlotID:             slotID,
+		BusinessUserID:     businessUserID,
+		InspectorUserID:    assignment.Inspector.ID,
+		AssignmentStrategy: assignment.Strategy,
+		AssignmentReason:   assignment.Reason,
+		Question:           question,
+	}
+
+	if _, err := tx.NewInsert().Model(&appointment).Returning("id").Exec(ctx); err != nil {
+		return ConsultationAppointment{}, wrapError("Insert", err)
+	}
+
+	return appointment, nil
+}
+
+// RescheduleConsultationAppointment moves the business user's active consultation appointment to a new slot
+// of the same authority, keeping the same inspector if they are available in the new slot, or otherwise choosing
+// another one using the assigner. ErrNotFound is returned if the appointment doesn't exist, and ErrConsultationSlotExhausted
+// if the new slot has no available inspectors, in which case the appointment is left as is.
+func (db *Database) RescheduleConsultationAppointment(ctx context.Context, consultationID string, newSlotID, businessUserID int64,
+	assign Assigner,
+) (InspectorUser, error) {
+	var assignment Assignment
+
+	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
+		var appointment ConsultationAppointment
+		err := tx.NewSelect().Model(&appointment).
+			Where("id = ?", consultationID).
+			Where("business_user_id = ?", businessUserID).
+			Where("canceled_at is null").
+			For("update").
+			Scan(ctx)
+		if err != nil {
+			return wrapError("Appointment", err)
+		}
+
+		// The appointment itself doesn't occupy the inspector, so that it can be moved within the same slot
 		var availableInspectors []InspectorUser
-		err := tx.NewSelect().Model(&availableInspectors).
+		err = tx.NewSelect().Model(&availableInspectors).
 			Join("join authority_consultation_slots acs on acs.authority_id = iu.authority_id").
-			Join("left join consultation_appointment ca on ca.slot_id = acs.id and ca.inspector_user_id = iu.id and ca.canceled_at is null").
-			Where("acs.id = ?", slotID).
+			Join("join authority_consultation_slots old_acs on old_acs.authority_id = acs.authority_id").
+			Join(joinInspectorSlotAppointments+" and ca.id != ?", appointment.ID).
+			Where("acs.id = ?", newSlotID).
+			Where("acs.from_time > now()").
+			Where("old_acs.id = ?", appointment.SlotID).
 			Where("ca.id is null").
+			Where(inspectorOnDutyCondition).
+			Where(inspectorNotHeldCondition).
+			Apply(whereInspectorQualified(appointment.TopicID)).
 			Scan(ctx)
 		if err != nil {
 			return wrapError("Inspectors", err)
@@ -86,33 +260,56 @@ func (db *Database) CreateConsultationAppointment(ctx context.Context, topicID,
 			return ErrConsultationSlotExhausted
 		}
 
-		chosenInspector = availableInspectors[rand.Intn(len(availableInspectors))]
-		appointment := ConsultationAppointment{
-			TopicID:         topicID,
-			SlotID:          slotID,
-			BusinessUserID:  businessUserID,
-			InspectorUserID: chosenInspector.ID,
+		update := tx.NewUpdate().Model((*ConsultationAppointment)(nil)).
+			Set("slot_id = ?", newSlotID).
+			Where("id = ?", appointment.ID)
+
+		if inspector, ok := lo.Find(availableInspectors, func(i InspectorUser) bool {
+			return i.ID == appointment.InspectorUserID
+		}); ok {
+			assignment = Assignment{Inspector: inspector}
+		} else {
+			assignment, err = assignInspectorTx(ctx, tx, assign, availableInspectors, newSlotID, businessUserID)
+			if err != nil {
+				return err
+			}
+
+			update = update.
+				Set("inspector_user_id = ?", assignment.Inspector.ID).
+				Set("assigned_at = now()").
+				Set("assignment_strategy = ?", assignment.Strategy).
+				Set("assignment_reason = ?", assignment.Reason)
 		}
 
-		if _, err := tx.NewInsert().Model(&appointment).Returning("").Exec(ctx); err != nil {
-			return wrapError("Insert", err)
+		_, err = update.Returning("").Exec(ctx)
+		if err = wrapError("Update", err); errors.Is(err, ErrAlreadyExists) {
+			// The inspector has been taken by a concurrent appointment
+			return ErrConsultationSlotExhausted
+		} else if err != nil {
+			return err
 		}
 
 		return nil
 	})
 	if err != nil {
-		return InspectorUser{}, wrapError("CreateConsultationAppointment", err)
+		return InspectorUser{}, wrapError("RescheduleConsultationAppointment", err)
 	}
 
-	return chosenInspector, nil
+	return assignment.Inspector, nil
 }
 
-// CancelConsultationAppointment labels the specified consultation as canceled if it belongs to this user.
-func (db *Database) CancelConsultationAppointment(ctx context.Context, consultationID string, businessUserID int64) error {
+// CancelConsultationAppointment labels the specified consultation as canceled if it belongs to this user
+// and hasn't been canceled yet. The reason is optional for business users.
+func (db *Database) CancelConsultationAppointment(ctx context.Context, consultationID string, businessUserID int64,
+	reason string,
+) error {
 	result, err := db.bun.NewUpdate().Model((*ConsultationAppointment)(nil)).
 		Set("canceled_at = now()").
+		Set("canceled_by = ?", AccountTypeBusiness).
+		Set("cancel_reason = nullif(?, '')", reason).
 		Where("ca.id = ?", consultationID).
 		Where("ca.business_user_id = ?", businessUserID).
+		Where("ca.canceled_at is null").
 		Exec(ctx)
 	if err != nil {
 		return wrapError("CancelConsultationAppointment", err)
@@ -127,6 +324,68 @@ func (db *Database) CancelConsultationAppointment(ctx context.Context, consultat
 	return nil
 }
 
+// CancelInspectorConsultationAppointment labels the specified consultation as canceled with the reason
+// if it's assigned to the inspector with the specified account and hasn't been canceled yet.
+// The appointment is marked as declined, so that the slot isn't booked with the inspector again.
+func (db *Database) CancelInspectorConsultationAppointment(ctx context.Context, consultationID string, accountID int64,
+	reason string,
+) error {
+	selectInspectorUserID := db.bun.NewSelect().Model((*InspectorUser)(nil)).
+		Column("id").
+		Where("account_id = ?", accountID)
+
+	result, err := db.bun.NewUpdate().Model((*ConsultationAppointment)(nil)).
+		Set("canceled_at = now()").
+		Set("canceled_by = ?", AccountTypeAuthority).
+		Set("cancel_reason = ?", reason).
+		Set("declined_by_inspector = true").
+		Where("ca.id = ?", consultationID).
+		Where("ca.inspector_user_id = (?)", selectInspectorUserID).
+		Where("ca.canceled_at is null").
+		Exec(ctx)
+	if err != nil {
+		return wrapError("CancelInspectorConsultationAppointment", err)
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return wrapError("CancelInspectorConsultationAppointment.RowsAffected", err)
+	} else if affected < 1 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// CancelAuthorityConsultationAppointment labels the specified consultation as canceled with the reason
+// if it's assigned to one of the authority's inspectors and hasn't been canceled yet.
+func (db *Database) CancelAuthorityConsultationAppointment(ctx context.Context, consultationID string, authorityID int64,
+	reason string,
+) error {
+	selectInspectorUserIDs := db.bun.NewSelect().Model((*InspectorUser)(nil)).
+		Column("id").
+		Where("authority_id = ?", authorityID)
+
+	result, err := db.bun.NewUpdate().Model((*ConsultationAppointment)(nil)).
+		Set("canceled_at = now()").
+		Set("canceled_by = ?", AccountTypeAuthority).
+		Set("cancel_reason = ?", reason).
+		Where("ca.id = ?", consultationID).
+		Where("ca.inspector_user_id in (?)", selectInspectorUserIDs).
+		Where("ca.canceled_at is null").
+		Exec(ctx)
+	if err != nil {
+		return wrapError("CancelAuthorityConsultationAppointment", err)
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return wrapError("CancelAuthorityConsultationAppointment.RowsAffected", err)
+	} else if affected < 1 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
 // ListConsultationTopics returns a list of all of the consultation topics.
 func (db *Database) ListConsultationTopics(ctx context.Context) ([]ConsultationTopic, error) {
 	var topics []ConsultationTopic
@@ -138,8 +397,9 @@ func (db *Database) ListConsultationTopics(ctx context.Context) ([]ConsultationT
 	return topics, nil
 }
 
-// ListConsultationSlots returns a list of available dates for the specified authority and date range.
-func (db *Database) ListAvailableConsultationDates(ctx context.Context, authorityID int64, from_date, to_date time.Time,
+// ListAvailableConsultationDates returns a list of available dates for the specified authority and date range.
+// If the topic is specified, only the inspectors competent in it are considered.
+func (db *Database) ListAvailableConsultationDates(ctx context.Context, authorityID, topicID int64, from_date, to_date time.Time,
 ) ([]time.Time, error) {
 	var dates []time.Time
 
@@ -148,12 +408,15 @@ func (db *Database) ListAvailableConsultationDates(ctx context.Context, authorit
 	err := db.bun.NewSelect().Model((*ConsultationSlot)(nil)).
 		Column("acs.from_time").
 		Join("join inspector_user iu on iu.authority_id = acs.authority_id").
-		Join("left join consultation_appointment ca on ca.slot_id = acs.id and ca.inspector_user_id = iu.id and ca.canceled_at is null").
+		Join(joinInspectorSlotAppointments).
 		Where("acs.authority_id = ?", authorityID).
 		Where("acs.from_time > now()").
 		Where("acs.from_time::date >= ?::date", from_date).
 		Where("acs.to_time::date <= ?::date", to_date).
 		Where("ca.id is null").
+		Where(inspectorOnDutyCondition).
+		Where(inspectorNotHeldCondition).
+		Apply(whereInspectorQualified(topicID)).
 		Scan(ctx, &dates)
 	if err != nil {
 		return nil, wrapError("ListAvailableConsultationDates", err)
@@ -163,18 +426,22 @@ func (db *Database) ListAvailableConsultationDates(ctx context.Context, authorit
 }
 
 // ListAvailableConsultationSlots returns a list of available consultation slots for the specified authority and date.
-func (db *Database) ListAvailableConsultationSlots(ctx context.Context, authorityID int64, date time.Time,
+// If the topic is specified, only the inspectors competent in it are considered.
+func (db *Database) ListAvailableConsultationSlots(ctx context.Context, authorityID, topicID int64, date time.Time,
 ) ([]ConsultationSlot, error) {
 	var slots []ConsultationSlot
 
 	// Like the query in ListAvailableConsultationDates but filters based on specific date instead of range
 	err := db.bun.NewSelect().Model(&slots).
 		Join("join inspector_user iu on iu.authority_id = acs.authority_id").
-		Join("left join consultation_appointment ca on ca.slot_id = acs.id and ca.inspector_user_id = iu.id and ca.canceled_at is null").
+		Join(joinInspectorSlotAppointments).
 		Where("acs.authority_id = ?", authorityID).
 		Where("acs.from_time > now()").
 		Where("acs.from_time::date = ?::date", date).
 		Where("ca.id is null").
+		Where(inspectorOnDutyCondition).
+		Where(inspectorNotHeldCondition).
+		Apply(whereInspectorQualified(topicID)).
 		Scan(ctx)
 	if err != nil {
 		return nil, wrapError("ListAvailableConsultationSlots", err)
@@ -194,9 +461,10 @@ func (db *Database) ListBusinessConsultationAppointments(ctx context.Context, ac
 		Where("account_id = ?", accountID)
 
 	err := db.bun.NewSelect().Model(&appointments).
-		Column("ca.id", "ca.canceled_at").
+		Column("ca.id", "ca.canceled_at", "ca.canceled_by", "ca.cancel_reason", "ca.question").
 		ColumnExpr("authority.name as inspector_user__authority__name").
 		Relation("Topic").
+		Relation("Attachments", orderAttachments).
 		Relation("Slot").
 		Relation("BusinessUser").
 		Relation("InspectorUser").
@@ -222,9 +490,10 @@ func (db *Database) ListInspectorConsultationAppointments(ctx context.Context, a
 		Where("account_id = ?", accountID)
 
 	err := db.bun.NewSelect().Model(&appointments).
-		Column("ca.id", "ca.canceled_at").
+		Column("ca.id", "ca.canceled_at", "ca.canceled_by", "ca.cancel_reason", "ca.question").
 		ColumnExpr("authority.name as inspector_user__authority__name").
 		Relation("Topic").
+		Relation("Attachments", orderAttachments).
 		Relation("Slot").
 		Relation("BusinessUser").
 		Relation("InspectorUser").
@@ -238,3 +507,55 @@ func (db *Database) ListInspectorConsultationAppointments(ctx context.Context, a
 
 	return appointments, nil
 }
+
+// ListAuthorityConsultationAppointments lists consultation appointments of all of the inspectors
+// of the authority to which the specified authority user belongs.
+func (db *Database) ListAuthorityConsultationAppointments(ctx context.Context, accountID int64,
+) ([]ConsultationAppointment, error) {
+	selectAuthorityID := db.bun.NewSelect().Model((*InspectorUser)(nil)).
+		Column("authority_id").
+		Where("account_id = ?", accountID)
+
+	appointments, err := db.listAuthorityConsultationAppointments(ctx, selectAuthorityID)
+	if err != nil {
+		return nil, wrapError("ListAuthorityConsultationAppointments", err)
+	}
+
+	return appointments, nil
+}
+
+// ListConsultationAppointmentsByAuthority lists consultation appointments of all of the inspectors of the authority.
+func (db *Database) ListConsultationAppointmentsByAuthority(ctx context.Context, authorityID int64,
+) ([]ConsultationAppointment, error) {
+	appointments, err := db.listAuthorityConsultationAppointments(ctx, authorityID)
+	if err != nil {
+		return nil, wrapError("ListConsultationAppointmentsByAuthority", err)
+	}
+
+	return appointments, nil
+}
+
+// listAuthorityConsultationAppointments lists consultation appointments of the authority,
+// which can be specified either by ID or by a subquery selecting it.
+func (db *Database) listAuthorityConsultationAppointments(ctx context.Context, authorityID any,
+) ([]ConsultationAppointment, error) {
+	var appointments []ConsultationAppointment
+
+	err := db.bun.NewSelect().Model(&appointments).
+		Column("ca.id", "ca.canceled_at", "ca.canceled_by", "ca.cancel_reason", "ca.question").
+		ColumnExpr("authority.name as inspector_user__authority__name").
+		Relation("Topic").
+		Relation("Attachments", orderAttachments).
+		Relation("Slot").
+		Relation("BusinessUser").
+		Relation("InspectorUser").
+		Join("left join authority on inspector_user.authority_id = authority.id").
+		Where("inspector_user.authority_id = (?)", authorityID).
+		Order("slot.from_time").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return appointments, nil
+}
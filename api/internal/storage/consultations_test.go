@@ -0,0 +1,35 @@
+package storage
+
+import (
+	"strings"
+	"testing"
+)
+
+// Only the inspectors' own declines keep them unavailable in the slot, the cancelations made by the business users,
+// the supervisors or the integrations on behalf of the authority must free the inspector up.
+func TestInspectorSlotAppointmentsOnlyKeepDeclines(t *testing.T) {
+	query := newQueryDB().NewSelect().Model((*ConsultationSlot)(nil)).
+		Join("join inspector_user iu on iu.authority_id = acs.authority_id").
+		Join(joinInspectorSlotAppointments).
+		Where("ca.id is null").
+		String()
+
+	if !strings.Contains(query, "ca.canceled_at is null or ca.declined_by_inspector") {
+		t.Errorf("query doesn't keep the active and declined appointments: %s", query)
+	}
+	if strings.Contains(query, "canceled_by") {
+		t.Errorf("query depends on the type of the canceler: %s", query)
+	}
+}
+
+// Holds and bookings of any kind must not reserve inspectors in the slots which have already started.
+func TestSlotInspectorsOnlyInFutureSlots(t *testing.T) {
+	query := selectSlotInspectors(newQueryDB(), (*InspectorUser)(nil), 1, 2).String()
+
+	if !strings.Contains(query, "acs.from_time > now()") {
+		t.Errorf("query doesn't exclude the started slots: %s", query)
+	}
+	if !strings.Contains(query, "acs.id = 2") {
+		t.Errorf("query doesn't select the slot: %s", query)
+	}
+}
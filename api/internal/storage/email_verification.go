@@ -0,0 +1,122 @@
+package storage
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+var ErrVerificationCodeMismatch = errors.New("verification code doesn't match")
+
+type EmailVerification struct {
+	bun.BaseModel `bun:"table:email_verification,alias:ev"`
+
+	ID        int64     `bun:",pk,type:bigserial,autoincrement"`
+	AccountID int64     `bun:"type:bigint"`
+	Email     string    `bun:"type:text,notnull"`
+	CodeHash  []byte    `bun:"type:bytea,notnull"`
+	Attempts  int       `bun:"type:int,notnull"`
+	CreatedAt time.Time `bun:"type:timestamptz,default:now()"`
+	ExpiresAt time.Time `bun:"type:timestamptz,notnull"`
+}
+
+// CreateEmailVerification creates a pending verification of the email for the account,
+// replacing the previous one if it exists.
+func (db *Database) CreateEmailVerification(ctx context.Context, verification EmailVerification) error {
+	_, err := db.bun.NewInsert().Model(&verification).
+		On("conflict (account_id) do update").
+		Set("email = excluded.email").
+		Set("code_hash = excluded.code_hash").
+		Set("attempts = 0").
+		Set("created_at = now()").
+		Set("expires_at = excluded.expires_at").
+		Returning("").Exec(ctx)
+	if err != nil {
+		return wrapError("CreateEmailVerification", err)
+	}
+
+	return nil
+}
+
+// GetEmailVerification returns the pending unexpired email verification of the account.
+func (db *Database) GetEmailVerification(ctx context.Context, accountID int64) (EmailVerification, error) {
+	var verification EmailVerification
+
+	err := db.bun.NewSelect().Model(&verification).
+		Where("account_id = ?", accountID).
+		Where("expires_at > now()").
+		Scan(ctx)
+	if err != nil {
+		return EmailVerification{}, wrapError("GetEmailVerification", err)
+	}
+
+	return verification, nil
+}
+
+// VerifyEmail completes the pending email verification of the account if the code matches, setting the account's email
+// to the verified one. ErrNotFound is returned if no pending verification exists, and ErrVerificationCodeMismatch if the
+// code doesn't match, in which case the verification is discarded after the maximum number of attempts is reached.
+// ErrAlreadyExists is returned if the email is already used by a different account.
+func (db *Database) VerifyEmail(ctx context.Context, accountID int64, codeHash []byte, maxAttempts int) error {
+	var mismatch bool
+
+	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
+		var verification EmailVerification
+		err := tx.NewSelect().Model(&verification).
+			Where("account_id = ?", accountID).
+			Where("expires_at > now()").
+			For("update").
+			Scan(ctx)
+		if err != nil {
+			return wrapError("VerifyEmail.Select", err)
+		}
+
+		// Record the failed attempt and commit it
+		if subtle.ConstantTimeCompare(verification.CodeHash, codeHash) != 1 {
+			mismatch = true
+
+			if verification.Attempts+1 >= maxAttempts {
+				_, err = tx.NewDelete().Model((*EmailVerification)(nil)).
+					Where("id = ?", verification.ID).
+					Returning("").Exec(ctx)
+			} else {
+				_, err = tx.NewUpdate().Model((*EmailVerification)(nil)).
+					Set("attempts = attempts + 1").
+					Where("id = ?", verification.ID).
+					Returning("").Exec(ctx)
+			}
+
+			return wrapError("VerifyEmail.Attempt", err)
+		}
+
+		_, err = tx.NewUpdate().Model((*Account)(nil)).
+			Set("email = ?", verification.Email).
+			Set("email_verified_at = now()").
+			Where("id = ?", accountID).
+			Returning("").Exec(ctx)
+		if err != nil {
+			return wrapError("VerifyEmail.Account", err)
+		}
+
+		_, err = tx.NewDelete().Model((*EmailVerification)(nil)).
+			Where("id = ?", verification.ID).
+			Returning("").Exec(ctx)
+		if err != nil {
+			return wrapError("VerifyEmail.Delete", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("executing transaction: %w", err)
+	} else if mismatch {
+		return ErrVerificationCodeMismatch
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type ExternalIdentity struct {
+	bun.BaseModel `bun:"table:external_identity,alias:ei"`
+
+	ID        int64     `bun:",pk,type:bigserial,autoincrement"`
+	Provider  string    `bun:"type:text,notnull"`
+	Subject   string    `bun:"type:text,notnull"`
+	AccountID int64     `bun:"type:bigint,notnull"`
+	Account   Account   `bun:"rel:belongs-to,join:account_id=id"`
+	CreatedAt time.Time `bun:"type:timestamptz,default:now()"`
+}
+
+type ExternalLogin struct {
+	bun.BaseModel `bun:"table:external_login,alias:el"`
+
+	ID            int64     `bun:",pk,type:bigserial,autoincrement"`
+	StateHash     []byte    `bun:"type:bytea,notnull"`
+	Nonce         string    `bun:"type:text,notnull"`
+	CodeVerifier  string    `bun:"type:text,notnull"`
+	LinkAccountID *int64    `bun:"type:bigint"`
+	CreatedAt     time.Time `bun:"type:timestamptz,default:now()"`
+	ExpiresAt     time.Time `bun:"type:timestamptz,notnull"`
+}
+
+// CreateExternalLogin stores a started external login until it is completed, also deleting the expired ones.
+func (db *Database) CreateExternalLogin(ctx context.Context, login ExternalLogin) error {
+	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewDelete().Model((*ExternalLogin)(nil)).
+			Where("expires_at <= now()").
+			Returning("").Exec(ctx)
+		if err != nil {
+			return wrapError("CreateExternalLogin.Delete", err)
+		}
+
+		if _, err := tx.NewInsert().Model(&login).Returning("").Exec(ctx); err != nil {
+			return wrapError("CreateExternalLogin.Insert", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("executing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ConsumeExternalLogin deletes and returns the started external login with the state,
+// returning ErrNotFound if it doesn't exist or has expired. Each login can thus only be completed once.
+func (db *Database) ConsumeExternalLogin(ctx context.Context, stateHash []byte) (ExternalLogin, error) {
+	var login ExternalLogin
+
+	_, err := db.bun.NewDelete().Model(&login).
+		Where("state_hash = ?", stateHash).
+		Where("expires_at > now()").
+		Returning("*").Exec(ctx, &login)
+	if err != nil {
+		return ExternalLogin{}, wrapError("ConsumeExternalLogin", err)
+	} else if login.ID == 0 {
+		return ExternalLogin{}, ErrNotFound
+	}
+
+	return login, nil
+}
+
+// GetExternalIdentityAccount gets the account linked to the provider's subject.
+func (db *Database) GetExternalIdentityAccount(ctx context.Context, provider, subject string) (Account, error) {
+	var identity ExternalIdentity
+
+	err := db.bun.NewSelect().Model(&identity).
+		Relation("Account").
+		Where("ei.provider = ?", provider).
+		Where("ei.subject = ?", subject).
+		Scan(ctx)
+	if err != nil {
+		return Account{}, wrapError("GetExternalIdentityAccount", err)
+	}
+
+	return identity.Account, nil
+}
+
+// LinkExternalIdentity links the provider's subject to the account. ErrAlreadyExists is returned
+// if the subject is already linked to an account, or if the account is already linked to another subject.
+func (db *Database) LinkExternalIdentity(ctx context.Context, provider, subject string, accountID int64) error {
+	identity := ExternalIdentity{Provider: provider, Subject: subject, AccountID: accountID}
+	if _, err := db.bun.NewInsert().Model(&identity).Returning("").Exec(ctx); err != nil {
+		return wrapError("LinkExternalIdentity", err)
+	}
+
+	return nil
+}
+
+// CreateExternalBusinessUser creates a new business user account linked to the provider's subject,
+// returning the created account. The account doesn't have a password, one can be set via a password reset.
+// ErrAlreadyExists is returned if the email is already taken or the subject is already linked.
+func (db *Database) CreateExternalBusinessUser(ctx context.Context, provider, subject, email string, emailVerified bool,
+	user BusinessUser,
+) (Account, error) {
+	account := Account{
+		Type:         AccountTypeBusiness,
+		Role:         AccountRoleBusinessOwner,
+		Email:        email,
+		PasswordHash: []byte{},
+	}
+
+	if emailVerified {
+		verifiedAt := time.Now()
+		account.EmailVerifiedAt = &verifiedAt
+	}
+
+	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewInsert().Model(&account).Returning("id").Exec(ctx)
+		if err != nil {
+			return wrapError("CreateExternalBusinessUser.Account", err)
+		}
+
+		user.AccountID = account.ID
+		_, err = tx.NewInsert().Model(&user).Returning("").Exec(ctx)
+		if err != nil {
+			return wrapError("CreateExternalBusinessUser.User", err)
+		}
+
+		identity := ExternalIdentity{Provider: provider, Subject: subject, AccountID: account.ID}
+		if _, err := tx.NewInsert().Model(&identity).Returning("").Exec(ctx); err != nil {
+			return wrapError("CreateExternalBusinessUser.Identity", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return Account{}, fmt.Errorf("executing transaction: %w", err)
+	}
+
+	return account, nil
+}
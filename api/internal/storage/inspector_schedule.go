@@ -0,0 +1,180 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// inspectorOnDutyCondition filters the inspectors (iu) to the ones who are on duty during the slot (acs):
+// the slot must be within their working hours on its day of the week, if they have a schedule,
+// and must not overlap with any of their absences. Working hours are compared with the slot times in UTC,
+// which is how the times of the slots are specified when they are uploaded.
+const inspectorOnDutyCondition = `not exists (
+	select 1 from inspector_absence ia
+	where ia.inspector_user_id = iu.id and ia.from_time < acs.to_time and ia.to_time > acs.from_time
+) and (
+	not exists (select 1 from inspector_schedule isc where isc.inspector_user_id = iu.id)
+	or exists (
+		select 1 from inspector_schedule isc
+		where isc.inspector_user_id = iu.id
+		and isc.weekday = extract(isodow from acs.from_time at time zone 'UTC')
+		and isc.from_time <= (acs.from_time at time zone 'UTC')::time
+		and isc.to_time >= (acs.to_time at time zone 'UTC')::time
+	)
+)`
+
+type InspectorSchedule struct {
+	bun.BaseModel `bun:"table:inspector_schedule,alias:isc"`
+
+	ID              int64 `bun:",pk,type:bigserial,autoincrement"`
+	InspectorUserID int64 `bun:"type:bigint,notnull"`
+	// ISO day of the week, 1 is Monday
+	Weekday int `bun:"type:smallint,notnull"`
+	// Working hours in the "15:04" format
+	FromTime string `bun:"type:time,notnull"`
+	ToTime   string `bun:"type:time,notnull"`
+}
+
+type InspectorAbsence struct {
+	bun.BaseModel `bun:"table:inspector_absence,alias:ia"`
+
+	ID              int64                `bun:",pk,type:bigserial,autoincrement"`
+	InspectorUserID int64                `bun:"type:bigint,notnull"`
+	Kind            InspectorAbsenceKind `bun:"type:inspector_absence_kind,notnull"`
+	FromTime        time.Time            `bun:"type:timestamptz,notnull"`
+	ToTime          time.Time            `bun:"type:timestamptz,notnull"`
+	Comment         string               `bun:"type:text,notnull"`
+}
+
+// ListAuthorityInspectors lists all of the inspectors of the authority.
+func (db *Database) ListAuthorityInspectors(ctx context.Context, authorityID int64) ([]InspectorUser, error) {
+	var inspectors []InspectorUser
+
+	err := db.bun.NewSelect().Model(&inspectors).
+		Where("authority_id = ?", authorityID).
+		Order("id").
+		Scan(ctx)
+	if err != nil {
+		return nil, wrapError("ListAuthorityInspectors", err)
+	}
+
+	return inspectors, nil
+}
+
+// GetInspectorSchedule returns the weekly working hours of the inspector ordered by time.
+func (db *Database) GetInspectorSchedule(ctx context.Context, inspectorUserID int64) ([]InspectorSchedule, error) {
+	var schedule []InspectorSchedule
+
+	err := db.bun.NewSelect().Model(&schedule).
+		ColumnExpr("id, inspector_user_id, weekday").
+		ColumnExpr("to_char(from_time, 'HH24:MI') as from_time").
+		ColumnExpr("to_char(to_time, 'HH24:MI') as to_time").
+		Where("inspector_user_id = ?", inspectorUserID).
+		Order("weekday", "from_time").
+		Scan(ctx)
+	if err != nil {
+		return nil, wrapError("GetInspectorSchedule", err)
+	}
+
+	return schedule, nil
+}
+
+// SetInspectorSchedule replaces the weekly working hours of the inspector.
+// An empty schedule means that the inspector is always on duty.
+func (db *Database) SetInspectorSchedule(ctx context.Context, inspectorUserID int64, schedule []InspectorSchedule) error {
+	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
+		exists, err := tx.NewSelect().Model((*InspectorUser)(nil)).Where("id = ?", inspectorUserID).Exists(ctx)
+		if err != nil {
+			return wrapError("SetInspectorSchedule.Exists", err)
+		} else if !exists {
+			return ErrNotFound
+		}
+
+		_, err = tx.NewDelete().Model((*InspectorSchedule)(nil)).
+			Where("inspector_user_id = ?", inspectorUserID).
+			Returning("").Exec(ctx)
+		if err != nil {
+			return wrapError("SetInspectorSchedule.Delete", err)
+		}
+
+		if len(schedule) == 0 {
+			return nil
+		}
+
+		for i := range schedule {
+			schedule[i].InspectorUserID = inspectorUserID
+		}
+
+		if _, err := tx.NewInsert().Model(&schedule).Returning("").Exec(ctx); err != nil {
+			return wrapError("SetInspectorSchedule.Insert", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("executing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ListInspectorAbsences lists the absences of the inspector which haven't ended yet.
+func (db *Database) ListInspectorAbsences(ctx context.Context, inspectorUserID int64) ([]InspectorAbsence, error) {
+	var absences []InspectorAbsence
+
+	err := db.bun.NewSelect().Model(&absences).
+		Where("inspector_user_id = ?", inspectorUserID).
+		Where("to_time > now()").
+		Order("from_time").
+		Scan(ctx)
+	if err != nil {
+		return nil, wrapError("ListInspectorAbsences", err)
+	}
+
+	return absences, nil
+}
+
+// CreateInspectorAbsence records a new absence of the inspector, returning ErrNotFound if the inspector doesn't exist.
+func (db *Database) CreateInspectorAbsence(ctx context.Context, absence InspectorAbsence) (int64, error) {
+	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
+		exists, err := tx.NewSelect().Model((*InspectorUser)(nil)).Where("id = ?", absence.InspectorUserID).Exists(ctx)
+		if err != nil {
+			return wrapError("CreateInspectorAbsence.Exists", err)
+		} else if !exists {
+			return ErrNotFound
+		}
+
+		if _, err := tx.NewInsert().Model(&absence).Returning("id").Exec(ctx); err != nil {
+			return wrapError("CreateInspectorAbsence.Insert", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("executing transaction: %w", err)
+	}
+
+	return absence.ID, nil
+}
+
+// DeleteInspectorAbsence deletes the absence, returning ErrNotFound if it doesn't exist.
+func (db *Database) DeleteInspectorAbsence(ctx context.Context, id int64) error {
+	result, err := db.bun.NewDelete().Model((*InspectorAbsence)(nil)).
+		Where("id = ?", id).
+		Returning("").Exec(ctx)
+	if err != nil {
+		return wrapError("DeleteInspectorAbsence", err)
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return wrapError("DeleteInspectorAbsence.RowsAffected", err)
+	} else if affected < 1 {
+		return ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/samber/lo"
+	"github.com/uptrace/bun"
+)
+
+type InspectorTopic struct {
+	bun.BaseModel `bun:"table:inspector_topic,alias:it"`
+
+	InspectorUserID int64 `bun:",pk,type:bigint"`
+	TopicID         int64 `bun:",pk,type:bigint"`
+}
+
+// whereInspectorQualified filters the inspectors (iu) to the ones competent in the topic,
+// which includes the inspectors without any assigned topics. Nothing is filtered if the topic isn't specified.
+func whereInspectorQualified(topicID int64) func(q *bun.SelectQuery) *bun.SelectQuery {
+	return func(q *bun.SelectQuery) *bun.SelectQuery {
+		if topicID == 0 {
+			return q
+		}
+
+		return q.Where(`(
+			not exists (select 1 from inspector_topic it where it.inspector_user_id = iu.id)
+			or exists (select 1 from inspector_topic it where it.inspector_user_id = iu.id and it.topic_id = ?)
+		)`, topicID)
+	}
+}
+
+// ListAuthorityTopics lists the consultation topics of the authority.
+func (db *Database) ListAuthorityTopics(ctx context.Context, authorityID int64) ([]ConsultationTopic, error) {
+	var topics []ConsultationTopic
+
+	err := db.bun.NewSelect().Model(&topics).
+		Where("authority_id = ?", authorityID).
+		Order("id").
+		Scan(ctx)
+	if err != nil {
+		return nil, wrapError("ListAuthorityTopics", err)
+	}
+
+	return topics, nil
+}
+
+// GetInspectorTopics returns the topics assigned to the inspector.
+func (db *Database) GetInspectorTopics(ctx context.Context, inspectorUserID int64) ([]ConsultationTopic, error) {
+	var topics []ConsultationTopic
+
+	err := db.bun.NewSelect().Model(&topics).
+		Join("join inspector_topic it on it.topic_id = consultation_topic.id").
+		Where("it.inspector_user_id = ?", inspectorUserID).
+		Order("consultation_topic.id").
+		Scan(ctx)
+	if err != nil {
+		return nil, wrapError("GetInspectorTopics", err)
+	}
+
+	return topics, nil
+}
+
+// SetInspectorTopics replaces the topics assigned to the inspector. ErrNotFound is returned if the inspector doesn't exist
+// or any of the topics don't belong to the inspector's authority. An empty list allows the inspector to handle all topics.
+func (db *Database) SetInspectorTopics(ctx context.Context, inspectorUserID int64, topicIDs []int64) error {
+	topicIDs = lo.Uniq(topicIDs)
+
+	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
+		var inspector InspectorUser
+		if err := tx.NewSelect().Model(&inspector).Where("id = ?", inspectorUserID).Scan(ctx); err != nil {
+			return wrapError("SetInspectorTopics.Inspector", err)
+		}
+
+		_, err := tx.NewDelete().Model((*InspectorTopic)(nil)).
+			Where("inspector_user_id = ?", inspectorUserID).
+			Returning("").Exec(ctx)
+		if err != nil {
+			return wrapError("SetInspectorTopics.Delete", err)
+		}
+
+		if len(topicIDs) == 0 {
+			return nil
+		}
+
+		count, err := tx.NewSelect().Model((*ConsultationTopic)(nil)).
+			Where("id in (?)", bun.In(topicIDs)).
+			Where("authority_id = ?", inspector.AuthorityID).
+			Count(ctx)
+		if err != nil {
+			return wrapError("SetInspectorTopics.Topics", err)
+		} else if count != len(topicIDs) {
+			return ErrNotFound
+		}
+
+		topics := lo.Map(topicIDs, func(topicID int64, _ int) InspectorTopic {
+			return InspectorTopic{InspectorUserID: inspectorUserID, TopicID: topicID}
+		})
+
+		if _, err := tx.NewInsert().Model(&topics).Returning("").Exec(ctx); err != nil {
+			return wrapError("SetInspectorTopics.Insert", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("executing transaction: %w", err)
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/uptrace/bun"
 )
@@ -19,12 +20,17 @@ type InspectorUser struct {
 	LastName    string    `bun:"type:text,notnull"`
 }
 
-// CreateInspectorUser creates a new inspector user account and returns the created account's id.
-func (db *Database) CreateInspectorUser(ctx context.Context, email string, passwordHash []byte, user InspectorUser) error {
+// CreateInspectorUser creates a new inspector user account with the specified authority role.
+// The email of the account is considered verified since inspectors are created by the administrators.
+func (db *Database) CreateInspectorUser(ctx context.Context, email string, passwordHash []byte, role AccountRole, user InspectorUser,
+) error {
+	verifiedAt := time.Now()
 	account := Account{
-		Type:         AccountTypeAuthority,
-		Email:        email,
-		PasswordHash: passwordHash,
+		Type:            AccountTypeAuthority,
+		Role:            role,
+		Email:           email,
+		PasswordHash:    passwordHash,
+		EmailVerifiedAt: &verifiedAt,
 	}
 
 	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
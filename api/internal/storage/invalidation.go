@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/uptrace/bun/driver/pgdriver"
+)
+
+// Channels on which the database notifies about invalidated data, with the ID of the data as the payload.
+const (
+	AccountInvalidationChannel = "account_invalidated"
+	SessionInvalidationChannel = "session_invalidated"
+)
+
+const (
+	// Timeout after which the listener checks that it's still supposed to be listening
+	invalidationReceiveTimeout = time.Minute
+	// Delays before reconnecting after the listener's connection fails, doubled after each consecutive failure
+	invalidationRetryDelay    = time.Second
+	invalidationMaxRetryDelay = time.Minute
+)
+
+// ListenInvalidations listens for invalidation notifications until the context is canceled, calling handle for each one.
+// The listener reconnects with an exponential backoff after failures. Notifications sent while it's disconnected are lost,
+// so reset is called after each failure, after which all of the data derived from the database should be considered invalid
+// until ready is called once the listener is connected again. Ready is also called when the listener first connects.
+func (db *Database) ListenInvalidations(ctx context.Context,
+	handle func(channel, payload string), ready func(), reset func(err error),
+) {
+	delay := invalidationRetryDelay
+
+	for {
+		err := db.listenInvalidations(ctx, handle, func() {
+			delay = invalidationRetryDelay
+			ready()
+		})
+		if ctx.Err() != nil {
+			return
+		}
+
+		reset(err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+
+		if delay *= 2; delay > invalidationMaxRetryDelay {
+			delay = invalidationMaxRetryDelay
+		}
+	}
+}
+
+// listenInvalidations listens for invalidation notifications using a new connection until it fails.
+func (db *Database) listenInvalidations(ctx context.Context, handle func(channel, payload string), ready func()) error {
+	ln := pgdriver.NewListener(db.bun)
+	defer ln.Close()
+
+	if err := ln.Listen(ctx, AccountInvalidationChannel, SessionInvalidationChannel); err != nil {
+		return fmt.Errorf("listening for invalidations: %w", err)
+	}
+
+	ready()
+
+	for {
+		channel, payload, err := ln.ReceiveTimeout(ctx, invalidationReceiveTimeout)
+
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() && ctx.Err() == nil {
+			continue
+		} else if err != nil {
+			return fmt.Errorf("receiving invalidation: %w", err)
+		}
+
+		handle(channel, payload)
+	}
+}
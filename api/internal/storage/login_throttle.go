@@ -0,0 +1,176 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type LoginThrottle struct {
+	bun.BaseModel `bun:"table:login_throttle,alias:lt"`
+
+	Key           string     `bun:",pk,type:text"`
+	Failures      int        `bun:"type:int,notnull"`
+	LastFailureAt time.Time  `bun:"type:timestamptz,notnull"`
+	BlockedUntil  *time.Time `bun:"type:timestamptz"`
+}
+
+type LoginLockout struct {
+	bun.BaseModel `bun:"table:login_lockout,alias:ll"`
+
+	ID          int64      `bun:",pk,type:bigserial,autoincrement"`
+	Key         string     `bun:"type:text,notnull"`
+	Failures    int        `bun:"type:int,notnull"`
+	LockedAt    time.Time  `bun:"type:timestamptz,default:now()"`
+	LockedUntil time.Time  `bun:"type:timestamptz,notnull"`
+	ClearedAt   *time.Time `bun:"type:timestamptz"`
+}
+
+// GetLoginBlockedUntil returns the latest time until which any of the keys are blocked from logging in,
+// or nil if none of them are currently blocked.
+func (db *Database) GetLoginBlockedUntil(ctx context.Context, keys []string) (*time.Time, error) {
+	var blockedUntil *time.Time
+
+	err := db.bun.NewSelect().Model((*LoginThrottle)(nil)).
+		ColumnExpr("max(blocked_until)").
+		Where("key in (?)", bun.In(keys)).
+		Where("blocked_until > now()").
+		Scan(ctx, &blockedUntil)
+	if err != nil {
+		return nil, wrapError("GetLoginBlockedUntil", err)
+	}
+
+	return blockedUntil, nil
+}
+
+// RecordLoginFailure increments the number of failed login attempts for the key and returns the new number of failures.
+// Failures which happened before resetBefore are forgotten.
+func (db *Database) RecordLoginFailure(ctx context.Context, key string, resetBefore time.Time) (int, error) {
+	throttle := LoginThrottle{
+		Key:           key,
+		Failures:      1,
+		LastFailureAt: time.Now(),
+	}
+
+	_, err := db.bun.NewInsert().Model(&throttle).
+		On("conflict (key) do update").
+		Set("failures = case when lt.last_failure_at < ? then 1 else lt.failures + 1 end", resetBefore).
+		Set("last_failure_at = excluded.last_failure_at").
+		Returning("failures").
+		Exec(ctx)
+	if err != nil {
+		return 0, wrapError("RecordLoginFailure", err)
+	}
+
+	return throttle.Failures, nil
+}
+
+// BlockLogin blocks logging in for the key until the specified time.
+func (db *Database) BlockLogin(ctx context.Context, key string, until time.Time) error {
+	_, err := db.bun.NewUpdate().Model((*LoginThrottle)(nil)).
+		Set("blocked_until = ?", until).
+		Where("key = ?", key).
+		Returning("").Exec(ctx)
+	if err != nil {
+		return wrapError("BlockLogin", err)
+	}
+
+	return nil
+}
+
+// LockoutLogin blocks logging in for the key until the specified time and records this as a lockout
+// which can be viewed and cleared by the administrators. The failure counter of the key is reset.
+func (db *Database) LockoutLogin(ctx context.Context, key string, failures int, until time.Time) error {
+	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewUpdate().Model((*LoginThrottle)(nil)).
+			Set("failures = 0").
+			Set("blocked_until = ?", until).
+			Where("key = ?", key).
+			Returning("").Exec(ctx)
+		if err != nil {
+			return wrapError("LockoutLogin.Throttle", err)
+		}
+
+		lockout := LoginLockout{
+			Key:         key,
+			Failures:    failures,
+			LockedUntil: until,
+		}
+
+		if _, err := tx.NewInsert().Model(&lockout).Returning("").Exec(ctx); err != nil {
+			return wrapError("LockoutLogin.Lockout", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("executing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ResetLoginFailures forgets all of the failed login attempts for the key.
+func (db *Database) ResetLoginFailures(ctx context.Context, key string) error {
+	_, err := db.bun.NewDelete().Model((*LoginThrottle)(nil)).
+		Where("key = ?", key).
+		Where("blocked_until is null or blocked_until < now()").
+		Returning("").Exec(ctx)
+	if err != nil {
+		return wrapError("ResetLoginFailures", err)
+	}
+
+	return nil
+}
+
+// ListLoginLockouts returns the login lockouts which have happened after the specified time, the latest ones coming first.
+func (db *Database) ListLoginLockouts(ctx context.Context, after time.Time) ([]LoginLockout, error) {
+	var lockouts []LoginLockout
+
+	err := db.bun.NewSelect().Model(&lockouts).
+		Where("locked_at > ?", after).
+		Order("locked_at desc").
+		Scan(ctx)
+	if err != nil {
+		return nil, wrapError("ListLoginLockouts", err)
+	}
+
+	return lockouts, nil
+}
+
+// ClearLoginLockout clears an active lockout, allowing its key to log in again.
+// ErrNotFound is returned if no such active lockout exists.
+func (db *Database) ClearLoginLockout(ctx context.Context, id int64) error {
+	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
+		var lockout LoginLockout
+		_, err := tx.NewUpdate().Model(&lockout).
+			Set("cleared_at = now()").
+			Where("id = ?", id).
+			Where("cleared_at is null").
+			Where("locked_until > now()").
+			Returning("key").
+			Exec(ctx)
+		if err != nil {
+			return wrapError("ClearLoginLockout.Lockout", err)
+		} else if lockout.Key == "" {
+			return ErrNotFound
+		}
+
+		_, err = tx.NewDelete().Model((*LoginThrottle)(nil)).
+			Where("key = ?", lockout.Key).
+			Returning("").Exec(ctx)
+		if err != nil {
+			return wrapError("ClearLoginLockout.Throttle", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("executing transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type PasswordResetToken struct {
+	bun.BaseModel `bun:"table:password_reset_token,alias:prt"`
+
+	ID        int64      `bun:",pk,type:bigserial,autoincrement"`
+	AccountID int64      `bun:"type:bigint"`
+	TokenHash []byte     `bun:"type:bytea,notnull"`
+	CreatedAt time.Time  `bun:"type:timestamptz,default:now()"`
+	ExpiresAt time.Time  `bun:"type:timestamptz,notnull"`
+	UsedAt    *time.Time `bun:"type:timestamptz"`
+}
+
+// CreatePasswordResetToken creates a new password reset token for the account.
+// Previously created tokens which haven't been used yet are invalidated.
+func (db *Database) CreatePasswordResetToken(ctx context.Context, accountID int64, tokenHash []byte, expiresAt time.Time) error {
+	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewDelete().Model((*PasswordResetToken)(nil)).
+			Where("account_id = ?", accountID).
+			Where("used_at is null").
+			Returning("").Exec(ctx)
+		if err != nil {
+			return wrapError("CreatePasswordResetToken.Delete", err)
+		}
+
+		token := PasswordResetToken{
+			AccountID: accountID,
+			TokenHash: tokenHash,
+			ExpiresAt: expiresAt,
+		}
+
+		if _, err := tx.NewInsert().Model(&token).Returning("").Exec(ctx); err != nil {
+			return wrapError("CreatePasswordResetToken.Insert", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("executing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ResetPassword uses a valid password reset token to set a new password hash for the token's account, and returns the account's ID.
+// All of the account's sessions are revoked. ErrNotFound is returned if the token doesn't exist, has expired, or has already been used.
+func (db *Database) ResetPassword(ctx context.Context, tokenHash []byte, passwordHash []byte) (int64, error) {
+	var token PasswordResetToken
+
+	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
+		err := tx.NewSelect().Model(&token).
+			Where("token_hash = ?", tokenHash).
+			Where("expires_at > now()").
+			Where("used_at is null").
+			For("update").
+			Scan(ctx)
+		if err != nil {
+			return wrapError("ResetPassword.Select", err)
+		}
+
+		_, err = tx.NewUpdate().Model((*PasswordResetToken)(nil)).
+			Set("used_at = now()").
+			Where("id = ?", token.ID).
+			Returning("").Exec(ctx)
+		if err != nil {
+			return wrapError("ResetPassword.Token", err)
+		}
+
+		_, err = tx.NewUpdate().Model((*Account)(nil)).
+			Set("password_hash = ?", passwordHash).
+			Where("id = ?", token.AccountID).
+			Returning("").Exec(ctx)
+		if err != nil {
+			return wrapError("ResetPassword.Account", err)
+		}
+
+		return revokeSessionsTx(ctx, tx, token.AccountID)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("executing transaction: %w", err)
+	}
+
+	return token.AccountID, nil
+}
@@ -0,0 +1,92 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+var ErrRefreshTokenReused = errors.New("refresh token has already been used")
+
+type RefreshToken struct {
+	bun.BaseModel `bun:"table:refresh_token,alias:rt"`
+
+	ID        int64          `bun:",pk,type:bigserial,autoincrement"`
+	AccountID int64          `bun:"type:bigint"`
+	SessionID string         `bun:"type:uuid"`
+	Session   AccountSession `bun:"rel:belongs-to,join:session_id=id"`
+	TokenHash []byte         `bun:"type:bytea,notnull"`
+	CreatedAt time.Time      `bun:"type:timestamptz,default:now()"`
+	ExpiresAt time.Time      `bun:"type:timestamptz,notnull"`
+	UsedAt    *time.Time     `bun:"type:timestamptz"`
+}
+
+// RotateRefreshToken exchanges a valid refresh token for a new one from the same session and returns the
+// session the tokens belong to along with its account. ErrNotFound is returned if the token doesn't exist,
+// has expired, or its session has been revoked. If the token has already been used, the whole session
+// is revoked and ErrRefreshTokenReused is returned, since this means that the token has most probably been leaked.
+func (db *Database) RotateRefreshToken(ctx context.Context, tokenHash, newTokenHash []byte, expiresAt time.Time,
+) (AccountSession, error) {
+	var token RefreshToken
+	var reused bool
+
+	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
+		err := tx.NewSelect().Model(&token).
+			Relation("Session.Account").
+			Where("rt.token_hash = ?", tokenHash).
+			Where("rt.expires_at > now()").
+			Where("session.revoked_at is null").
+			For("update of rt").
+			Scan(ctx)
+		if err != nil {
+			return wrapError("RotateRefreshToken.Select", err)
+		}
+
+		// Revoke the session and commit the revocation
+		if token.UsedAt != nil {
+			reused = true
+			return revokeSessionsTx(ctx, tx, token.AccountID, token.SessionID)
+		}
+
+		_, err = tx.NewUpdate().Model((*RefreshToken)(nil)).
+			Set("used_at = now()").
+			Where("id = ?", token.ID).
+			Returning("").Exec(ctx)
+		if err != nil {
+			return wrapError("RotateRefreshToken.Update", err)
+		}
+
+		newToken := RefreshToken{
+			AccountID: token.AccountID,
+			SessionID: token.SessionID,
+			TokenHash: newTokenHash,
+			ExpiresAt: expiresAt,
+		}
+
+		if _, err := tx.NewInsert().Model(&newToken).Returning("").Exec(ctx); err != nil {
+			return wrapError("RotateRefreshToken.Insert", err)
+		}
+
+		_, err = tx.NewUpdate().Model((*AccountSession)(nil)).
+			Set("last_seen_at = now()").
+			Set("expires_at = ?", expiresAt).
+			Where("id = ?", token.SessionID).
+			Returning("").Exec(ctx)
+		if err != nil {
+			return wrapError("RotateRefreshToken.Session", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return AccountSession{}, fmt.Errorf("executing transaction: %w", err)
+	} else if reused {
+		return token.Session, ErrRefreshTokenReused
+	}
+
+	return token.Session, nil
+}
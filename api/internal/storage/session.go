@@ -0,0 +1,186 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type AccountSession struct {
+	bun.BaseModel `bun:"table:account_session,alias:s"`
+
+	ID         string     `bun:",pk,type:uuid,default:gen_random_uuid()"`
+	AccountID  int64      `bun:"type:bigint"`
+	Account    Account    `bun:"rel:belongs-to,join:account_id=id"`
+	Device     string     `bun:"type:text,notnull"`
+	IPAddress  string     `bun:"type:text,notnull"`
+	CreatedAt  time.Time  `bun:"type:timestamptz,default:now()"`
+	LastSeenAt time.Time  `bun:"type:timestamptz,default:now()"`
+	ExpiresAt  time.Time  `bun:"type:timestamptz,notnull"`
+	RevokedAt  *time.Time `bun:"type:timestamptz"`
+}
+
+// CreateSession creates a new session for the account along with its first refresh token
+// and returns the ID of the created session. Expired sessions of the account are cleaned up along the way.
+func (db *Database) CreateSession(ctx context.Context, session AccountSession, refreshTokenHash []byte) (string, error) {
+	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewDelete().Model((*AccountSession)(nil)).
+			Where("account_id = ?", session.AccountID).
+			Where("expires_at < now()").
+			Returning("").Exec(ctx)
+		if err != nil {
+			return wrapError("CreateSession.Cleanup", err)
+		}
+
+		if _, err := tx.NewInsert().Model(&session).Returning("id").Exec(ctx); err != nil {
+			return wrapError("CreateSession.Session", err)
+		}
+
+		token := RefreshToken{
+			AccountID: session.AccountID,
+			SessionID: session.ID,
+			TokenHash: refreshTokenHash,
+			ExpiresAt: session.ExpiresAt,
+		}
+
+		if _, err := tx.NewInsert().Model(&token).Returning("").Exec(ctx); err != nil {
+			return wrapError("CreateSession.RefreshToken", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("executing transaction: %w", err)
+	}
+
+	return session.ID, nil
+}
+
+// GetActiveSession returns the session with the given ID if it belongs to the account and hasn't expired or been revoked.
+func (db *Database) GetActiveSession(ctx context.Context, sessionID string, accountID int64) (AccountSession, error) {
+	var session AccountSession
+
+	err := db.bun.NewSelect().Model(&session).
+		Where("id = ?", sessionID).
+		Where("account_id = ?", accountID).
+		Where("expires_at > now()").
+		Where("revoked_at is null").
+		Scan(ctx)
+	if err != nil {
+		return AccountSession{}, wrapError("GetActiveSession", err)
+	}
+
+	return session, nil
+}
+
+// TouchSession updates the time at which the session was last seen to the current time.
+func (db *Database) TouchSession(ctx context.Context, sessionID string) error {
+	_, err := db.bun.NewUpdate().Model((*AccountSession)(nil)).
+		Set("last_seen_at = now()").
+		Where("id = ?", sessionID).
+		Returning("").Exec(ctx)
+	if err != nil {
+		return wrapError("TouchSession", err)
+	}
+
+	return nil
+}
+
+// ListActiveSessions lists all of the active sessions of the account, the most recently seen ones coming first.
+func (db *Database) ListActiveSessions(ctx context.Context, accountID int64) ([]AccountSession, error) {
+	var sessions []AccountSession
+
+	err := db.bun.NewSelect().Model(&sessions).
+		Where("account_id = ?", accountID).
+		Where("expires_at > now()").
+		Where("revoked_at is null").
+		Order("last_seen_at desc").
+		Scan(ctx)
+	if err != nil {
+		return nil, wrapError("ListActiveSessions", err)
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession revokes the specified session of the account, returning ErrNotFound if no such active session exists.
+func (db *Database) RevokeSession(ctx context.Context, sessionID string, accountID int64) error {
+	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
+		exists, err := tx.NewSelect().Model((*AccountSession)(nil)).
+			Where("id = ?", sessionID).
+			Where("account_id = ?", accountID).
+			Where("revoked_at is null").
+			Exists(ctx)
+		if err != nil {
+			return wrapError("RevokeSession.Select", err)
+		} else if !exists {
+			return ErrNotFound
+		}
+
+		return revokeSessionsTx(ctx, tx, accountID, sessionID)
+	})
+	if err != nil {
+		return fmt.Errorf("executing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// revokeOtherSessionsTx revokes all of the sessions of the account except for the specified one.
+// All of the sessions are revoked if none is specified, for example, when the request is made with a partial
+// token issued during login, which doesn't belong to any session yet.
+func revokeOtherSessionsTx(ctx context.Context, tx bun.Tx, accountID int64, keepSessionID string) error {
+	var sessionIDs []string
+
+	err := selectOtherSessionIDs(tx, accountID, keepSessionID).Scan(ctx, &sessionIDs)
+	if err != nil {
+		return wrapError("RevokeOtherSessions", err)
+	} else if len(sessionIDs) == 0 {
+		return nil
+	}
+
+	return revokeSessionsTx(ctx, tx, accountID, sessionIDs...)
+}
+
+// selectOtherSessionIDs selects the IDs of the active sessions of the account except for the specified one, if any.
+func selectOtherSessionIDs(db bun.IDB, accountID int64, keepSessionID string) *bun.SelectQuery {
+	q := db.NewSelect().Model((*AccountSession)(nil)).
+		Column("id").
+		Where("account_id = ?", accountID).
+		Where("revoked_at is null")
+
+	if keepSessionID != "" {
+		q = q.Where("id != ?", keepSessionID)
+	}
+
+	return q
+}
+
+// revokeSessionsTx revokes the specified sessions of the account, or all of them if none are specified,
+// deleting the refresh tokens which could be used to continue them.
+func revokeSessionsTx(ctx context.Context, tx bun.Tx, accountID int64, sessionIDs ...string) error {
+	revokeQuery := tx.NewUpdate().Model((*AccountSession)(nil)).
+		Set("revoked_at = now()").
+		Where("account_id = ?", accountID).
+		Where("revoked_at is null")
+	deleteQuery := tx.NewDelete().Model((*RefreshToken)(nil)).
+		Where("account_id = ?", accountID)
+
+	if len(sessionIDs) > 0 {
+		revokeQuery = revokeQuery.Where("id in (?)", bun.In(sessionIDs))
+		deleteQuery = deleteQuery.Where("session_id in (?)", bun.In(sessionIDs))
+	}
+
+	if _, err := revokeQuery.Returning("").Exec(ctx); err != nil {
+		return wrapError("RevokeSessions.Session", err)
+	}
+
+	if _, err := deleteQuery.Returning("").Exec(ctx); err != nil {
+		return wrapError("RevokeSessions.RefreshToken", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package storage
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+func newQueryDB() *bun.DB {
+	return bun.NewDB(&sql.DB{}, pgdialect.New())
+}
+
+// Partial tokens issued during a forced two-factor enrollment don't belong to a session, so enabling
+// the second factor with them must revoke all of the sessions instead of comparing the uuid IDs with "".
+func TestSelectOtherSessionIDsPartialToken(t *testing.T) {
+	query := selectOtherSessionIDs(newQueryDB(), 1, "").String()
+
+	if strings.Contains(query, "id !=") {
+		t.Errorf("query for a partial token excludes a session: %s", query)
+	}
+	if !strings.Contains(query, "account_id = 1") {
+		t.Errorf("query isn't limited to the account: %s", query)
+	}
+}
+
+func TestSelectOtherSessionIDsKeepsSession(t *testing.T) {
+	const sessionID = "5b3f6f8e-2f4e-4c1b-9d0a-1f2e3d4c5b6a"
+
+	query := selectOtherSessionIDs(newQueryDB(), 1, sessionID).String()
+
+	if !strings.Contains(query, "id != '"+sessionID+"'") {
+		t.Errorf("query doesn't exclude the current session: %s", query)
+	}
+}
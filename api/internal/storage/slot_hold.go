@@ -0,0 +1,129 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// inspectorNotHeldCondition filters the inspectors (iu) to the ones who aren't reserved in the slot (acs)
+// by an active hold, which is treated the same way as an appointment until it expires.
+const inspectorNotHeldCondition = `not exists (
+	select 1 from consultation_slot_hold csh
+	where csh.slot_id = acs.id and csh.inspector_user_id = iu.id and csh.expires_at > now()
+)`
+
+type SlotHold struct {
+	bun.BaseModel `bun:"table:consultation_slot_hold,alias:csh"`
+
+	ID              int64  `bun:",pk,type:bigserial,autoincrement"`
+	TokenHash       []byte `bun:"type:bytea,unique,notnull"`
+	SlotID          int64  `bun:"type:bigint"`
+	BusinessUserID  int64  `bun:"type:bigint"`
+	InspectorUserID int64  `bun:"type:bigint"`
+	// Assignment of the held inspector, which is transferred to the appointment
+	AssignmentStrategy AssignmentStrategy `bun:"type:assignment_strategy,nullzero"`
+	AssignmentReason   string             `bun:"type:text,nullzero"`
+	CreatedAt          time.Time          `bun:"type:timestamptz,nullzero,default:now()"`
+	ExpiresAt          time.Time          `bun:"type:timestamptz,notnull"`
+}
+
+// CreateSlotHold reserves one of the inspectors available in the slot who are competent in the topic, chosen using
+// the assigner, for the business user until the expiry time. The previous holds of the user are released, since
+// only one appointment is booked at a time. ErrConsultationSlotExhausted is returned if the slot has no available inspectors.
+func (db *Database) CreateSlotHold(ctx context.Context, topicID, slotID, businessUserID int64, tokenHash []byte,
+	expiresAt time.Time, assign Assigner,
+) error {
+	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
+		// Expired holds of the slot would otherwise conflict with the new one until they are swept
+		_, err := tx.NewDelete().Model((*SlotHold)(nil)).
+			Where("business_user_id = ? or (slot_id = ? and expires_at <= now())", businessUserID, slotID).
+			Returning("").Exec(ctx)
+		if err != nil {
+			return wrapError("Release", err)
+		}
+
+		availableInspectors, err := listSlotInspectorsTx(ctx, tx, topicID, slotID)
+		if err != nil {
+			return err
+		} else if len(availableInspectors) == 0 {
+			return ErrConsultationSlotExhausted
+		}
+
+		assignment, err := assignInspectorTx(ctx, tx, assign, availableInspectors, slotID, businessUserID)
+		if err != nil {
+			return err
+		}
+
+		hold := SlotHold{
+			TokenHash:          tokenHash,
+			SlotID:             slotID,
+			BusinessUserID:     businessUserID,
+			InspectorUserID:    assignment.Inspector.ID,
+			AssignmentStrategy: assignment.Strategy,
+			AssignmentReason:   assignment.Reason,
+			ExpiresAt:          expiresAt,
+		}
+
+		if _, err := tx.NewInsert().Model(&hold).Returning("").Exec(ctx); err != nil {
+			if err = wrapError("Insert", err); errors.Is(err, ErrAlreadyExists) {
+				// The inspector has been held by a concurrent request
+				return ErrConsultationSlotExhausted
+			}
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return wrapError("CreateSlotHold", err)
+	}
+
+	return nil
+}
+
+// DeleteExpiredSlotHolds deletes the holds which have expired and returns their number.
+func (db *Database) DeleteExpiredSlotHolds(ctx context.Context) (int64, error) {
+	result, err := db.bun.NewDelete().Model((*SlotHold)(nil)).
+		Where("expires_at <= now()").
+		Returning("").Exec(ctx)
+	if err != nil {
+		return 0, wrapError("DeleteExpiredSlotHolds", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, wrapError("DeleteExpiredSlotHolds.RowsAffected", err)
+	}
+
+	return affected, nil
+}
+
+// deleteAccountSlotHolds deletes the holds of the account's business users.
+func deleteAccountSlotHolds(db bun.IDB, accountID int64) *bun.DeleteQuery {
+	return db.NewDelete().Model((*SlotHold)(nil)).
+		Where("business_user_id in (?)", selectAccountBusinessUserIDs(db, accountID)).
+		Returning("")
+}
+
+// releaseSlotHoldTx deletes the business user's active hold of the slot with the token hash and returns it.
+// ErrNotFound is returned if there's no such hold, for example, because it has already expired.
+func releaseSlotHoldTx(ctx context.Context, tx bun.Tx, tokenHash []byte, slotID, businessUserID int64) (SlotHold, error) {
+	var hold SlotHold
+
+	err := tx.NewDelete().Model(&hold).
+		Where("token_hash = ?", tokenHash).
+		Where("slot_id = ?", slotID).
+		Where("business_user_id = ?", businessUserID).
+		Where("expires_at > now()").
+		Returning("*").
+		Scan(ctx)
+	if err != nil {
+		return SlotHold{}, wrapError("ReleaseSlotHold", err)
+	}
+
+	return hold, nil
+}
@@ -0,0 +1,210 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/uptrace/bun"
+)
+
+type SlotTemplate struct {
+	bun.BaseModel `bun:"table:slot_template,alias:st"`
+
+	ID          int64 `bun:",pk,type:bigserial,autoincrement"`
+	AuthorityID int64 `bun:"type:bigint,notnull"`
+	// ISO day of the week, 1 is Monday
+	Weekday int `bun:"type:smallint,notnull"`
+	// Time window in the "15:04" format which is split into slots of the specified length
+	FromTime    string     `bun:"type:time,notnull"`
+	ToTime      string     `bun:"type:time,notnull"`
+	SlotMinutes int        `bun:"type:integer,notnull"`
+	ValidFrom   time.Time  `bun:"type:date,notnull"`
+	ValidUntil  *time.Time `bun:"type:date"`
+	CreatedAt   time.Time  `bun:"type:timestamptz,default:now()"`
+}
+
+type SlotException struct {
+	bun.BaseModel `bun:"table:slot_exception,alias:se"`
+
+	ID          int64     `bun:",pk,type:bigserial,autoincrement"`
+	AuthorityID int64     `bun:"type:bigint,notnull"`
+	Date        time.Time `bun:"type:date,notnull"`
+	Comment     string    `bun:"type:text,notnull"`
+}
+
+// ListSlotTemplateAuthorities returns the IDs of the authorities which have slot templates.
+func (db *Database) ListSlotTemplateAuthorities(ctx context.Context) ([]int64, error) {
+	var authorityIDs []int64
+
+	err := db.bun.NewSelect().Model((*SlotTemplate)(nil)).
+		Distinct().
+		Column("authority_id").
+		Scan(ctx, &authorityIDs)
+	if err != nil {
+		return nil, wrapError("ListSlotTemplateAuthorities", err)
+	}
+
+	return authorityIDs, nil
+}
+
+// GetAuthoritySlotSchedule returns the slot templates and exceptions of the authority.
+func (db *Database) GetAuthoritySlotSchedule(ctx context.Context, authorityID int64,
+) ([]SlotTemplate, []SlotException, error) {
+	var templates []SlotTemplate
+	var exceptions []SlotException
+
+	err := db.bun.NewSelect().Model(&templates).
+		ColumnExpr("id, authority_id, weekday, slot_minutes, valid_from, valid_until, created_at").
+		ColumnExpr("to_char(from_time, 'HH24:MI') as from_time").
+		ColumnExpr("to_char(to_time, 'HH24:MI') as to_time").
+		Where("authority_id = ?", authorityID).
+		Order("weekday", "from_time").
+		Scan(ctx)
+	if err != nil {
+		return nil, nil, wrapError("GetAuthoritySlotSchedule.Templates", err)
+	}
+
+	err = db.bun.NewSelect().Model(&exceptions).
+		Where("authority_id = ?", authorityID).
+		Order("date").
+		Scan(ctx)
+	if err != nil {
+		return nil, nil, wrapError("GetAuthoritySlotSchedule.Exceptions", err)
+	}
+
+	return templates, exceptions, nil
+}
+
+// CreateSlotTemplate creates a new slot template for the authority.
+func (db *Database) CreateSlotTemplate(ctx context.Context, template SlotTemplate) (int64, error) {
+	if _, err := db.bun.NewInsert().Model(&template).Returning("id").Exec(ctx); err != nil {
+		return 0, wrapError("CreateSlotTemplate", err)
+	}
+
+	return template.ID, nil
+}
+
+// DeleteSlotTemplate deletes the authority's slot template along with the future slots generated from it
+// which haven't been booked, returning ErrNotFound if it doesn't exist. Booked slots are kept.
+func (db *Database) DeleteSlotTemplate(ctx context.Context, authorityID, id int64) error {
+	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewDelete().Model((*ConsultationSlot)(nil)).
+			Where("acs.template_id = ?", id).
+			Where("acs.authority_id = ?", authorityID).
+			Where("acs.from_time > now()").
+			Where("not exists (select 1 from consultation_appointment ca where ca.slot_id = acs.id)").
+			Returning("").Exec(ctx)
+		if err != nil {
+			return wrapError("DeleteSlotTemplate.Slots", err)
+		}
+
+		result, err := tx.NewDelete().Model((*SlotTemplate)(nil)).
+			Where("id = ?", id).
+			Where("authority_id = ?", authorityID).
+			Returning("").Exec(ctx)
+		if err != nil {
+			return wrapError("DeleteSlotTemplate.Template", err)
+		}
+
+		if affected, err := result.RowsAffected(); err != nil {
+			return wrapError("DeleteSlotTemplate.RowsAffected", err)
+		} else if affected < 1 {
+			return ErrNotFound
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("executing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// CreateSlotException creates a new slot exception for the authority,
+// returning ErrAlreadyExists if one already exists for the date.
+func (db *Database) CreateSlotException(ctx context.Context, exception SlotException) (int64, error) {
+	if _, err := db.bun.NewInsert().Model(&exception).Returning("id").Exec(ctx); err != nil {
+		return 0, wrapError("CreateSlotException", err)
+	}
+
+	return exception.ID, nil
+}
+
+// DeleteSlotException deletes the authority's slot exception, returning ErrNotFound if it doesn't exist.
+func (db *Database) DeleteSlotException(ctx context.Context, authorityID, id int64) error {
+	result, err := db.bun.NewDelete().Model((*SlotException)(nil)).
+		Where("id = ?", id).
+		Where("authority_id = ?", authorityID).
+		Returning("").Exec(ctx)
+	if err != nil {
+		return wrapError("DeleteSlotException", err)
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return wrapError("DeleteSlotException.RowsAffected", err)
+	} else if affected < 1 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// SyncTemplateSlots creates the slots generated from the authority's templates which don't exist yet, and deletes
+// the previously generated slots starting in the specified period which are no longer expected and haven't been booked.
+func (db *Database) SyncTemplateSlots(ctx context.Context, authorityID int64, slots []ConsultationSlot, from, to time.Time,
+) error {
+	type slotKey struct {
+		templateID int64
+		from, to   int64
+	}
+
+	expected := lo.SliceToMap(slots, func(s ConsultationSlot) (slotKey, struct{}) {
+		return slotKey{lo.FromPtr(s.TemplateID), s.FromTime.Unix(), s.ToTime.Unix()}, struct{}{}
+	})
+
+	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
+		if len(slots) > 0 {
+			if _, err := tx.NewInsert().Model(&slots).On("conflict do nothing").Returning("").Exec(ctx); err != nil {
+				return wrapError("SyncTemplateSlots.Insert", err)
+			}
+		}
+
+		var existing []ConsultationSlot
+		err := tx.NewSelect().Model(&existing).
+			Where("acs.authority_id = ?", authorityID).
+			Where("acs.template_id is not null").
+			Where("acs.from_time > ?", from).
+			Where("acs.from_time < ?", to).
+			Scan(ctx)
+		if err != nil {
+			return wrapError("SyncTemplateSlots.Select", err)
+		}
+
+		stale := lo.FilterMap(existing, func(s ConsultationSlot, _ int) (int64, bool) {
+			_, ok := expected[slotKey{lo.FromPtr(s.TemplateID), s.FromTime.Unix(), s.ToTime.Unix()}]
+			return s.ID, !ok
+		})
+		if len(stale) == 0 {
+			return nil
+		}
+
+		_, err = tx.NewDelete().Model((*ConsultationSlot)(nil)).
+			Where("acs.id in (?)", bun.In(stale)).
+			Where("not exists (select 1 from consultation_appointment ca where ca.slot_id = acs.id)").
+			Returning("").Exec(ctx)
+		if err != nil {
+			return wrapError("SyncTemplateSlots.Delete", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("executing transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,239 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/uptrace/bun"
+)
+
+type AccountTOTP struct {
+	bun.BaseModel `bun:"table:account_totp,alias:at"`
+
+	AccountID    int64      `bun:",pk,type:bigint"`
+	Secret       []byte     `bun:"type:bytea,notnull"`
+	CreatedAt    time.Time  `bun:"type:timestamptz,default:now()"`
+	EnabledAt    *time.Time `bun:"type:timestamptz"`
+	LastUsedStep *int64     `bun:"type:bigint"`
+}
+
+type RecoveryCode struct {
+	bun.BaseModel `bun:"table:recovery_code,alias:rc"`
+
+	ID        int64      `bun:",pk,type:bigserial,autoincrement"`
+	AccountID int64      `bun:"type:bigint,notnull"`
+	CodeHash  []byte     `bun:"type:bytea,notnull"`
+	UsedAt    *time.Time `bun:"type:timestamptz"`
+}
+
+// TwoFactorStatus describes the two-factor authentication state of an account.
+type TwoFactorStatus struct {
+	// Enabled is true if the account has confirmed the TOTP enrollment
+	Enabled bool
+	// Required is true if the account's authority requires two-factor authentication
+	Required bool
+}
+
+// GetTwoFactorStatus returns the two-factor authentication state of the account.
+func (db *Database) GetTwoFactorStatus(ctx context.Context, accountID int64) (TwoFactorStatus, error) {
+	var status TwoFactorStatus
+
+	enabled, err := db.bun.NewSelect().Model((*AccountTOTP)(nil)).
+		Where("account_id = ?", accountID).
+		Where("enabled_at is not null").
+		Exists(ctx)
+	if err != nil {
+		return TwoFactorStatus{}, wrapError("GetTwoFactorStatus.TOTP", err)
+	}
+
+	required, err := db.bun.NewSelect().Model((*InspectorUser)(nil)).
+		Join("join authority as a on a.id = iu.authority_id").
+		Where("iu.account_id = ?", accountID).
+		Where("a.require_two_factor").
+		Exists(ctx)
+	if err != nil {
+		return TwoFactorStatus{}, wrapError("GetTwoFactorStatus.Authority", err)
+	}
+
+	status.Enabled = enabled
+	status.Required = required
+	return status, nil
+}
+
+// GetAccountTOTP returns the account's TOTP enrollment, which might not be enabled yet.
+func (db *Database) GetAccountTOTP(ctx context.Context, accountID int64) (AccountTOTP, error) {
+	var totp AccountTOTP
+	if err := db.bun.NewSelect().Model(&totp).Where("account_id = ?", accountID).Scan(ctx); err != nil {
+		return AccountTOTP{}, wrapError("GetAccountTOTP", err)
+	}
+
+	return totp, nil
+}
+
+// CreateAccountTOTP starts a new TOTP enrollment for the account, replacing the previous unconfirmed one.
+// ErrAlreadyExists is returned if the account already has TOTP enabled.
+func (db *Database) CreateAccountTOTP(ctx context.Context, accountID int64, secret []byte) error {
+	totp := AccountTOTP{AccountID: accountID, Secret: secret}
+
+	result, err := db.bun.NewInsert().Model(&totp).
+		On("conflict (account_id) do update").
+		Set("secret = excluded.secret").
+		Set("created_at = excluded.created_at").
+		Where("at.enabled_at is null").
+		Returning("").Exec(ctx)
+	if err != nil {
+		return wrapError("CreateAccountTOTP", err)
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return wrapError("CreateAccountTOTP.RowsAffected", err)
+	} else if affected < 1 {
+		return ErrAlreadyExists
+	}
+
+	return nil
+}
+
+// EnableAccountTOTP confirms the account's TOTP enrollment using the time step of the code used for the confirmation,
+// and sets the account's recovery codes. ErrNotFound is returned if there's no unconfirmed enrollment.
+// All of the account's sessions except for the specified one are revoked, since they have been created without 2FA.
+func (db *Database) EnableAccountTOTP(ctx context.Context, accountID int64, step int64, recoveryCodeHashes [][]byte,
+	keepSessionID string,
+) error {
+	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
+		result, err := tx.NewUpdate().Model((*AccountTOTP)(nil)).
+			Set("enabled_at = now()").
+			Set("last_used_step = ?", step).
+			Where("account_id = ?", accountID).
+			Where("enabled_at is null").
+			Returning("").Exec(ctx)
+		if err != nil {
+			return wrapError("EnableAccountTOTP.Update", err)
+		}
+
+		if affected, err := result.RowsAffected(); err != nil {
+			return wrapError("EnableAccountTOTP.RowsAffected", err)
+		} else if affected < 1 {
+			return ErrNotFound
+		}
+
+		if err := replaceRecoveryCodesTx(ctx, tx, accountID, recoveryCodeHashes); err != nil {
+			return err
+		}
+
+		return revokeOtherSessionsTx(ctx, tx, accountID, keepSessionID)
+	})
+	if err != nil {
+		return fmt.Errorf("executing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// UseTOTPStep marks the time step of a valid code as used. ErrNotFound is returned if TOTP isn't enabled
+// for the account or if a code with the same or a later time step has already been used.
+func (db *Database) UseTOTPStep(ctx context.Context, accountID int64, step int64) error {
+	result, err := useTOTPStep(db.bun, accountID, step).Exec(ctx)
+	if err != nil {
+		return wrapError("UseTOTPStep", err)
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return wrapError("UseTOTPStep.RowsAffected", err)
+	} else if affected < 1 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// useTOTPStep updates the last used time step of the account's TOTP only if it's later than the stored one.
+func useTOTPStep(db bun.IDB, accountID int64, step int64) *bun.UpdateQuery {
+	return db.NewUpdate().Model((*AccountTOTP)(nil)).
+		Set("last_used_step = ?", step).
+		Where("account_id = ?", accountID).
+		Where("enabled_at is not null").
+		Where("last_used_step is null or last_used_step < ?", step).
+		Returning("")
+}
+
+// UseRecoveryCode marks the recovery code as used, returning ErrNotFound if it doesn't exist or has already been used.
+func (db *Database) UseRecoveryCode(ctx context.Context, accountID int64, codeHash []byte) error {
+	result, err := db.bun.NewUpdate().Model((*RecoveryCode)(nil)).
+		Set("used_at = now()").
+		Where("account_id = ?", accountID).
+		Where("code_hash = ?", codeHash).
+		Where("used_at is null").
+		Returning("").Exec(ctx)
+	if err != nil {
+		return wrapError("UseRecoveryCode", err)
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return wrapError("UseRecoveryCode.RowsAffected", err)
+	} else if affected < 1 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// ReplaceRecoveryCodes replaces all of the account's recovery codes with new ones.
+func (db *Database) ReplaceRecoveryCodes(ctx context.Context, accountID int64, codeHashes [][]byte) error {
+	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
+		return replaceRecoveryCodesTx(ctx, tx, accountID, codeHashes)
+	})
+	if err != nil {
+		return fmt.Errorf("executing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteAccountTOTP disables two-factor authentication for the account, deleting its secret and recovery codes.
+func (db *Database) DeleteAccountTOTP(ctx context.Context, accountID int64) error {
+	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewDelete().Model((*AccountTOTP)(nil)).
+			Where("account_id = ?", accountID).
+			Returning("").Exec(ctx)
+		if err != nil {
+			return wrapError("DeleteAccountTOTP.TOTP", err)
+		}
+
+		_, err = tx.NewDelete().Model((*RecoveryCode)(nil)).
+			Where("account_id = ?", accountID).
+			Returning("").Exec(ctx)
+		if err != nil {
+			return wrapError("DeleteAccountTOTP.RecoveryCode", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("executing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func replaceRecoveryCodesTx(ctx context.Context, tx bun.Tx, accountID int64, codeHashes [][]byte) error {
+	_, err := tx.NewDelete().Model((*RecoveryCode)(nil)).
+		Where("account_id = ?", accountID).
+		Returning("").Exec(ctx)
+	if err != nil {
+		return wrapError("ReplaceRecoveryCodes.Delete", err)
+	}
+
+	codes := lo.Map(codeHashes, func(hash []byte, _ int) RecoveryCode {
+		return RecoveryCode{AccountID: accountID, CodeHash: hash}
+	})
+
+	if _, err := tx.NewInsert().Model(&codes).Returning("").Exec(ctx); err != nil {
+		return wrapError("ReplaceRecoveryCodes.Insert", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package storage
+
+import (
+	"strings"
+	"testing"
+)
+
+// A code can only be used once, so the step is only stored if it's later than the last used one,
+// and the code is rejected otherwise by the update not affecting any rows.
+func TestUseTOTPStepRejectsReusedSteps(t *testing.T) {
+	query := useTOTPStep(newQueryDB(), 1, 37037037).String()
+
+	if !strings.Contains(query, "(last_used_step is null or last_used_step < 37037037)") {
+		t.Errorf("query doesn't reject the same or earlier steps: %s", query)
+	}
+	if !strings.Contains(query, "enabled_at is not null") {
+		t.Errorf("query accepts codes of unconfirmed enrollments: %s", query)
+	}
+}
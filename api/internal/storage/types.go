@@ -7,9 +7,59 @@ const (
 	AccountTypeAuthority = "authority"
 )
 
+type AccountRole string
+
+const (
+	AccountRoleBusinessOwner       = "business_owner"
+	AccountRoleBusinessEmployee    = "business_employee"
+	AccountRoleInspector           = "inspector"
+	AccountRoleAuthoritySupervisor = "authority_supervisor"
+)
+
+// AccountTypeRoles are the roles which can be assigned to accounts of each type.
+var AccountTypeRoles = map[AccountType][]AccountRole{
+	AccountTypeBusiness:  {AccountRoleBusinessOwner, AccountRoleBusinessEmployee},
+	AccountTypeAuthority: {AccountRoleInspector, AccountRoleAuthoritySupervisor},
+}
+
+type AdminRole string
+
+const (
+	// Super admins can additionally manage other admins
+	AdminRoleSuperAdmin = "super_admin"
+	AdminRoleAdmin      = "admin"
+	// Viewers can only view the data without modifying it
+	AdminRoleViewer = "viewer"
+)
+
 type PersonSex string
 
 const (
 	PersonSexMale   = "male"
 	PersonSexFemale = "female"
 )
+
+type APIKeyScope string
+
+const (
+	// Read-only keys can only view the authority's data
+	APIKeyScopeRead  = "read"
+	APIKeyScopeWrite = "write"
+)
+
+type InspectorAbsenceKind string
+
+const (
+	InspectorAbsenceKindVacation  = "vacation"
+	InspectorAbsenceKindSickLeave = "sick_leave"
+	InspectorAbsenceKindOther     = "other"
+)
+
+type AssignmentStrategy string
+
+const (
+	AssignmentStrategyRandom            = "random"
+	AssignmentStrategyLeastLoaded       = "least_loaded"
+	AssignmentStrategyRoundRobin        = "round_robin"
+	AssignmentStrategyPreviousInspector = "previous_inspector"
+)
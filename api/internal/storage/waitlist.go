@@ -0,0 +1,221 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type WaitlistEntry struct {
+	bun.BaseModel `bun:"table:consultation_waitlist,alias:cw"`
+
+	ID             int64             `bun:",pk,type:bigserial,autoincrement"`
+	BusinessUserID int64             `bun:"type:bigint"`
+	BusinessUser   BusinessUser      `bun:"rel:belongs-to,join:business_user_id=id"`
+	AuthorityID    int64             `bun:"type:bigint"`
+	TopicID        int64             `bun:"type:bigint"`
+	Topic          ConsultationTopic `bun:"rel:belongs-to,join:topic_id=id"`
+	FromDate       time.Time         `bun:"type:date,notnull"`
+	ToDate         time.Time         `bun:"type:date,notnull"`
+	CreatedAt      time.Time         `bun:"type:timestamptz,nullzero,default:now()"`
+	FulfilledAt    *time.Time        `bun:"type:timestamptz"`
+	AppointmentID  *string           `bun:"type:uuid"`
+}
+
+// WaitlistBooking is an appointment booked for a business user from the waitlist.
+type WaitlistBooking struct {
+	Entry       WaitlistEntry
+	Appointment ConsultationAppointment
+	Slot        ConsultationSlot
+	Inspector   InspectorUser
+}
+
+// CreateWaitlistEntry adds the business user to the waitlist of the authority's topic and returns the entry's id.
+// ErrNotFound is returned if the topic doesn't belong to the authority,
+// and ErrAlreadyExists if the user is already waiting for a slot for this topic in dates which haven't passed yet.
+func (db *Database) CreateWaitlistEntry(ctx context.Context, entry WaitlistEntry) (int64, error) {
+	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
+		exists, err := tx.NewSelect().Model((*ConsultationTopic)(nil)).
+			Where("id = ?", entry.TopicID).
+			Where("authority_id = ?", entry.AuthorityID).
+			Exists(ctx)
+		if err != nil {
+			return wrapError("Topic", err)
+		} else if !exists {
+			return ErrNotFound
+		}
+
+		// Lapsed entries would otherwise prevent the user from waiting for the topic again until they are deleted
+		_, err = tx.NewDelete().Model((*WaitlistEntry)(nil)).
+			Where("business_user_id = ?", entry.BusinessUserID).
+			Where("topic_id = ?", entry.TopicID).
+			Where("fulfilled_at is null").
+			Where("to_date < current_date").
+			Returning("").Exec(ctx)
+		if err != nil {
+			return wrapError("DeleteLapsed", err)
+		}
+
+		if _, err := tx.NewInsert().Model(&entry).Returning("id").Exec(ctx); err != nil {
+			return wrapError("Insert", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, wrapError("CreateWaitlistEntry", err)
+	}
+
+	return entry.ID, nil
+}
+
+// DeleteWaitlistEntry removes the business user from the waitlist of the topic.
+// ErrNotFound is returned if the user isn't waiting for a slot for this topic.
+func (db *Database) DeleteWaitlistEntry(ctx context.Context, businessUserID, topicID int64) error {
+	result, err := db.bun.NewDelete().Model((*WaitlistEntry)(nil)).
+		Where("business_user_id = ?", businessUserID).
+		Where("topic_id = ?", topicID).
+		Where("fulfilled_at is null").
+		Where("to_date >= current_date").
+		Returning("").Exec(ctx)
+	if err != nil {
+		return wrapError("DeleteWaitlistEntry", err)
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return wrapError("DeleteWaitlistEntry.RowsAffected", err)
+	} else if affected < 1 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// DeleteLapsedWaitlistEntries deletes the entries which haven't been fulfilled before their dates have passed
+// and returns their number.
+func (db *Database) DeleteLapsedWaitlistEntries(ctx context.Context) (int64, error) {
+	result, err := db.bun.NewDelete().Model((*WaitlistEntry)(nil)).
+		Where("fulfilled_at is null").
+		Where("to_date < current_date").
+		Returning("").Exec(ctx)
+	if err != nil {
+		return 0, wrapError("DeleteLapsedWaitlistEntries", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, wrapError("DeleteLapsedWaitlistEntries.RowsAffected", err)
+	}
+
+	return affected, nil
+}
+
+// selectAccountBusinessUserIDs selects the IDs of the business users of the account.
+func selectAccountBusinessUserIDs(db bun.IDB, accountID int64) *bun.SelectQuery {
+	return db.NewSelect().Model((*BusinessUser)(nil)).
+		Column("id").
+		Where("account_id = ?", accountID)
+}
+
+// deleteAccountWaitlistEntries deletes the unfulfilled waitlist entries of the account's business users.
+// The fulfilled ones are kept along with the appointments booked from them.
+func deleteAccountWaitlistEntries(db bun.IDB, accountID int64) *bun.DeleteQuery {
+	return db.NewDelete().Model((*WaitlistEntry)(nil)).
+		Where("business_user_id in (?)", selectAccountBusinessUserIDs(db, accountID)).
+		Where("fulfilled_at is null").
+		Returning("")
+}
+
+// selectPendingWaitlistEntries selects the waitlist entries which should still be booked into the model: unfulfilled,
+// with dates which haven't passed yet, of business users whose accounts haven't been deleted.
+func selectPendingWaitlistEntries(db bun.IDB, model any) *bun.SelectQuery {
+	return db.NewSelect().Model(model).
+		Where("cw.fulfilled_at is null").
+		Where("cw.to_date >= current_date").
+		Where("exists (select 1 from business_user bu where bu.id = cw.business_user_id and bu.account_id is not null)")
+}
+
+// ListPendingWaitlistEntries lists the entries of the waitlist which haven't been fulfilled yet, whose dates
+// haven't passed yet and whose users haven't deleted their accounts, in the order in which they should be served.
+func (db *Database) ListPendingWaitlistEntries(ctx context.Context) ([]WaitlistEntry, error) {
+	var entries []WaitlistEntry
+
+	err := selectPendingWaitlistEntries(db.bun, &entries).
+		Order("cw.created_at", "cw.id").
+		Scan(ctx)
+	if err != nil {
+		return nil, wrapError("ListPendingWaitlistEntries", err)
+	}
+
+	return entries, nil
+}
+
+// BookWaitlistEntry books the earliest available slot matching the pending waitlist entry
+// with an inspector chosen using the assigner and marks the entry as fulfilled.
+// ErrNotFound is returned if the entry isn't pending anymore or is being booked concurrently,
+// and ErrConsultationSlotExhausted if there are no matching slots available.
+func (db *Database) BookWaitlistEntry(ctx context.Context, entryID int64, assign Assigner) (WaitlistBooking, error) {
+	var booking WaitlistBooking
+
+	err := db.bun.RunInTx(ctx, &sql.TxOptions{ReadOnly: false}, func(ctx context.Context, tx bun.Tx) error {
+		err := selectPendingWaitlistEntries(tx, &booking.Entry).
+			Relation("BusinessUser").
+			Relation("Topic").
+			Where("cw.id = ?", entryID).
+			For("update of cw skip locked").
+			Scan(ctx)
+		if err != nil {
+			return wrapError("Entry", err)
+		}
+
+		entry := booking.Entry
+
+		// Like the query in ListAvailableConsultationSlots but for the whole range of the entry
+		err = tx.NewSelect().Model(&booking.Slot).
+			Join("join inspector_user iu on iu.authority_id = acs.authority_id").
+			Join(joinInspectorSlotAppointments).
+			Where("acs.authority_id = ?", entry.AuthorityID).
+			Where("acs.from_time > now()").
+			Where("acs.from_time::date >= ?::date", entry.FromDate).
+			Where("acs.to_time::date <= ?::date", entry.ToDate).
+			Where("ca.id is null").
+			Where(inspectorOnDutyCondition).
+			Where(inspectorNotHeldCondition).
+			Apply(whereInspectorQualified(entry.TopicID)).
+			Order("acs.from_time", "acs.id").
+			Limit(1).
+			Scan(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrConsultationSlotExhausted
+		} else if err != nil {
+			return wrapError("Slot", err)
+		}
+
+		var assignment Assignment
+		booking.Appointment, assignment, err = createConsultationAppointmentTx(ctx, tx,
+			entry.TopicID, booking.Slot.ID, entry.BusinessUserID, assign)
+		if err != nil {
+			return err
+		}
+		booking.Inspector = assignment.Inspector
+
+		_, err = tx.NewUpdate().Model((*WaitlistEntry)(nil)).
+			Set("fulfilled_at = now()").
+			Set("appointment_id = ?", booking.Appointment.ID).
+			Where("id = ?", entry.ID).
+			Returning("").Exec(ctx)
+		if err != nil {
+			return wrapError("Update", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return WaitlistBooking{}, wrapError("BookWaitlistEntry", err)
+	}
+
+	return booking, nil
+}
@@ -0,0 +1,36 @@
+package storage
+
+import (
+	"strings"
+	"testing"
+)
+
+// Deleting an account only detaches its business user, so the waitlist must stop booking appointments for it
+// both by deleting the entries along with the account and by skipping the entries of detached users.
+func TestDeletedAccountWaitlistEntriesAreNotBooked(t *testing.T) {
+	db := newQueryDB()
+
+	listQuery := selectPendingWaitlistEntries(db, &[]WaitlistEntry{}).String()
+	bookQuery := selectPendingWaitlistEntries(db, &WaitlistEntry{}).Where("cw.id = 1").String()
+	for name, query := range map[string]string{"list": listQuery, "book": bookQuery} {
+		if !strings.Contains(query, "bu.account_id is not null") {
+			t.Errorf("%s query doesn't skip the entries of deleted accounts: %s", name, query)
+		}
+		if !strings.Contains(query, "cw.fulfilled_at is null") || !strings.Contains(query, "cw.to_date >= current_date") {
+			t.Errorf("%s query doesn't skip the fulfilled and lapsed entries: %s", name, query)
+		}
+	}
+
+	deleteQuery := deleteAccountWaitlistEntries(db, 42).String()
+	if !strings.Contains(deleteQuery, `"business_user" AS "bu" WHERE (account_id = 42)`) {
+		t.Errorf("entries aren't deleted by the account's business users: %s", deleteQuery)
+	}
+	if !strings.Contains(deleteQuery, "fulfilled_at is null") {
+		t.Errorf("fulfilled entries of the booked appointments are deleted: %s", deleteQuery)
+	}
+
+	holdsQuery := deleteAccountSlotHolds(db, 42).String()
+	if !strings.Contains(holdsQuery, `"business_user" AS "bu" WHERE (account_id = 42)`) {
+		t.Errorf("holds aren't deleted by the account's business users: %s", holdsQuery)
+	}
+}
@@ -0,0 +1,132 @@
+package waitlist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"ldt-hack/api/internal/assignment"
+	"ldt-hack/api/internal/mail"
+	"ldt-hack/api/internal/storage"
+
+	"golang.org/x/exp/slog"
+)
+
+const timeLayout = "02.01.2006 15:04"
+
+// Processor books the consultation slots which open up for the business users on the waitlist
+// in the order in which they have joined it, and notifies them about the appointments by mail.
+type Processor struct {
+	logger  *slog.Logger
+	db      *storage.Database
+	mailer  mail.Sender
+	trigger chan struct{}
+}
+
+// NewProcessor creates a new waitlist processor.
+func NewProcessor(logger *slog.Logger, db *storage.Database, mailer mail.Sender) *Processor {
+	return &Processor{
+		logger:  logger.With("component", "waitlist"),
+		db:      db,
+		mailer:  mailer,
+		trigger: make(chan struct{}, 1),
+	}
+}
+
+// Notify signals that slots might have opened up, for example, due to a canceled appointment or a new slot import,
+// so that the waitlist is processed without waiting for the next interval. It never blocks.
+func (p *Processor) Notify() {
+	select {
+	case p.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// Run processes the waitlist immediately and then at the interval or when notified until the context is canceled.
+// The interval catches the slots which open up without a notification, such as the ones generated from slot templates.
+func (p *Processor) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.Process(ctx); err != nil {
+			p.logger.Error("failed to process consultation waitlist", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-p.trigger:
+		}
+	}
+}
+
+// Process tries to book a slot for each of the pending waitlist entries, starting with the ones which have waited the longest.
+// The entries whose dates have passed are deleted.
+func (p *Processor) Process(ctx context.Context) error {
+	if deleted, err := p.db.DeleteLapsedWaitlistEntries(ctx); err != nil {
+		return fmt.Errorf("deleting lapsed waitlist entries: %w", err)
+	} else if deleted > 0 {
+		p.logger.Debug("deleted lapsed waitlist entries", "count", deleted)
+	}
+
+	entries, err := p.db.ListPendingWaitlistEntries(ctx)
+	if err != nil {
+		return fmt.Errorf("listing pending waitlist entries: %w", err)
+	}
+
+	for _, entry := range entries {
+		booking, err := p.db.BookWaitlistEntry(ctx, entry.ID, assignment.Assign)
+		if errors.Is(err, storage.ErrConsultationSlotExhausted) || errors.Is(err, storage.ErrNotFound) {
+			// Nothing available for this entry, or it has been handled concurrently
+			continue
+		} else if errors.Is(err, storage.ErrAlreadyExists) {
+			// The slot has been taken by a concurrent booking, it will be retried during the next run
+			p.logger.Warn("waitlist entry slot taken concurrently", "waitlist_entry_id", entry.ID)
+			continue
+		} else if err != nil {
+			return fmt.Errorf("booking waitlist entry %d: %w", entry.ID, err)
+		}
+
+		p.logger.Info("booked consultation appointment from waitlist",
+			"waitlist_entry_id", entry.ID,
+			"appointment_id", booking.Appointment.ID,
+			"business_user_id", entry.BusinessUserID,
+		)
+
+		if err := p.sendBookingMail(ctx, booking); err != nil {
+			p.logger.Error("failed to notify business user about waitlist booking",
+				"waitlist_entry_id", entry.ID,
+				"business_user_id", entry.BusinessUserID,
+				"error", err,
+			)
+		}
+	}
+
+	return nil
+}
+
+func (p *Processor) sendBookingMail(ctx context.Context, booking storage.WaitlistBooking) error {
+	account, err := p.db.GetAccountByID(ctx, booking.Entry.BusinessUser.AccountID)
+	if err != nil {
+		return fmt.Errorf("getting business user account: %w", err)
+	}
+
+	if err := p.mailer.Send(ctx, mail.Message{
+		To:      account.Email,
+		Subject: "Запись на консультацию из листа ожидания",
+		Body: fmt.Sprintf("Освободилось время для консультации по теме «%s», и вы были на нее записаны.\n\n"+
+			"Время консультации: %s\nИнспектор: %s %s\n\n"+
+			"Если время вам не подходит, запись можно перенести или отменить в приложении.",
+			booking.Entry.Topic.Name,
+			booking.Slot.FromTime.UTC().Format(timeLayout),
+			booking.Inspector.FirstName, booking.Inspector.LastName,
+		),
+	}); err != nil {
+		return fmt.Errorf("sending booking mail: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+create table refresh_token (
+  id bigserial primary key,
+  account_id bigint not null references account (id) on delete cascade,
+  family_id uuid not null default gen_random_uuid(), -- all tokens received by rotating a single token
+  token_hash bytea unique not null,
+  created_at timestamptz not null default now(),
+  expires_at timestamptz not null,
+  used_at timestamptz
+);
+
+create index refresh_token_family_id_idx on refresh_token (family_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table refresh_token;
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+create table account_session (
+  id uuid primary key default gen_random_uuid(),
+  account_id bigint not null references account (id) on delete cascade,
+  device text not null,
+  ip_address text not null,
+  created_at timestamptz not null default now(),
+  last_seen_at timestamptz not null default now(),
+  expires_at timestamptz not null,
+  revoked_at timestamptz
+);
+
+create index account_session_account_id_idx on account_session (account_id);
+
+-- Existing refresh token families become sessions of their own
+insert into account_session (id, account_id, device, ip_address, created_at, last_seen_at, expires_at)
+  select family_id, min(account_id), '', '', min(created_at), max(created_at), max(expires_at)
+  from refresh_token group by family_id;
+
+drop index refresh_token_family_id_idx;
+alter table refresh_token rename column family_id to session_id;
+alter table refresh_token alter column session_id drop default;
+alter table refresh_token add foreign key (session_id) references account_session (id) on delete cascade;
+create index refresh_token_session_id_idx on refresh_token (session_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index refresh_token_session_id_idx;
+alter table refresh_token drop constraint refresh_token_session_id_fkey;
+alter table refresh_token alter column session_id set default gen_random_uuid();
+alter table refresh_token rename column session_id to family_id;
+create index refresh_token_family_id_idx on refresh_token (family_id);
+drop table account_session;
+-- +goose StatementEnd
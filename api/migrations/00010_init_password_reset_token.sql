@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+create table password_reset_token (
+  id bigserial primary key,
+  account_id bigint not null references account (id) on delete cascade,
+  token_hash bytea unique not null,
+  created_at timestamptz not null default now(),
+  expires_at timestamptz not null,
+  used_at timestamptz
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table password_reset_token;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table account add column email_verified_at timestamptz;
+-- Accounts created before verification was introduced are considered verified
+update account set email_verified_at = now();
+
+create table email_verification (
+  id bigserial primary key,
+  account_id bigint unique not null references account (id) on delete cascade,
+  email text not null,
+  code_hash bytea not null,
+  attempts int not null default 0,
+  created_at timestamptz not null default now(),
+  expires_at timestamptz not null
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table email_verification;
+alter table account drop column email_verified_at;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+create table login_throttle (
+  key text primary key, -- throttled entity, such as 'email:<email>' or 'ip:<address>'
+  failures int not null,
+  last_failure_at timestamptz not null,
+  blocked_until timestamptz
+);
+
+create table login_lockout (
+  id bigserial primary key,
+  key text not null,
+  failures int not null,
+  locked_at timestamptz not null default now(),
+  locked_until timestamptz not null,
+  cleared_at timestamptz
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table login_lockout;
+drop table login_throttle;
+-- +goose StatementEnd
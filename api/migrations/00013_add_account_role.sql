@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+create type account_role as enum ('business_owner', 'business_employee', 'inspector', 'authority_supervisor');
+
+alter table account add column role account_role;
+update account set role = 'business_owner' where type = 'business';
+update account set role = 'inspector' where type = 'authority';
+alter table account alter column role set not null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table account drop column role;
+drop type account_role;
+-- +goose StatementEnd
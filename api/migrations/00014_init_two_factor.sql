@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+create table account_totp (
+  account_id bigint primary key references account (id) on delete cascade,
+  secret bytea not null,
+  created_at timestamptz not null default now(),
+  enabled_at timestamptz, -- null until the enrollment is confirmed with a valid code
+  last_used_step bigint -- codes with this or earlier time steps are rejected to prevent replays
+);
+
+create table recovery_code (
+  id bigserial primary key,
+  account_id bigint not null references account (id) on delete cascade,
+  code_hash bytea not null,
+  used_at timestamptz,
+  unique (account_id, code_hash)
+);
+
+alter table authority add column require_two_factor boolean not null default false;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table authority drop column require_two_factor;
+drop table recovery_code;
+drop table account_totp;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+create type admin_role as enum ('super_admin', 'admin', 'viewer');
+
+create table admin_user (
+  id bigserial primary key,
+  username text unique not null,
+  password_hash bytea not null,
+  role admin_role not null,
+  disabled boolean not null default false,
+  created_at timestamptz not null default now(),
+  last_login_at timestamptz
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table admin_user;
+drop type admin_role;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Admin sessions issued before this time are rejected, set when the password is changed
+alter table admin_user add column sessions_valid_after timestamptz;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table admin_user drop column sessions_valid_after;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+create table external_identity (
+  id bigserial primary key,
+  provider text not null,
+  subject text not null, -- user ID at the provider
+  account_id bigint not null references account (id) on delete cascade,
+  created_at timestamptz not null default now(),
+  unique (provider, subject),
+  unique (account_id, provider)
+);
+
+-- Logins started via the provider which are waiting for the user to be redirected back
+create table external_login (
+  id bigserial primary key,
+  state_hash bytea unique not null,
+  nonce text not null,
+  code_verifier text not null,
+  link_account_id bigint references account (id) on delete cascade, -- set when linking to an existing account
+  created_at timestamptz not null default now(),
+  expires_at timestamptz not null
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table external_login;
+drop table external_identity;
+-- +goose StatementEnd
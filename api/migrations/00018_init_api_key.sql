@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+create type api_key_scope as enum ('read', 'write');
+
+create table api_key (
+  id bigserial primary key,
+  authority_id bigint not null references authority (id) on delete cascade,
+  name text not null,
+  key_hash bytea unique not null,
+  scope api_key_scope not null,
+  created_at timestamptz not null default now(),
+  expires_at timestamptz, -- null for keys which never expire
+  last_used_at timestamptz,
+  revoked_at timestamptz
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table api_key;
+drop type api_key_scope;
+-- +goose StatementEnd
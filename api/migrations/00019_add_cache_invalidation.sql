@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Notify the API instances about deleted accounts and revoked sessions so that they can invalidate their caches
+create function notify_account_invalidated() returns trigger as $$
+begin
+  perform pg_notify('account_invalidated', old.id::text);
+  return null;
+end;
+$$ language plpgsql;
+
+create trigger account_invalidated after delete on account
+  for each row execute function notify_account_invalidated();
+
+create function notify_session_invalidated() returns trigger as $$
+begin
+  if tg_op = 'DELETE' or new.revoked_at is not null then
+    perform pg_notify('session_invalidated', old.id::text);
+  end if;
+  return null;
+end;
+$$ language plpgsql;
+
+create trigger session_invalidated after update of revoked_at or delete on account_session
+  for each row execute function notify_session_invalidated();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop trigger session_invalidated on account_session;
+drop function notify_session_invalidated;
+drop trigger account_invalidated on account;
+drop function notify_account_invalidated;
+-- +goose StatementEnd
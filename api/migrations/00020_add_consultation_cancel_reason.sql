@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table consultation_appointment add column canceled_by account_type;
+alter table consultation_appointment add column cancel_reason text;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table consultation_appointment drop column cancel_reason;
+alter table consultation_appointment drop column canceled_by;
+-- +goose StatementEnd
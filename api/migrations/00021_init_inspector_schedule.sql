@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Weekly working hours of the inspectors, inspectors without any are considered to be always on duty
+create table inspector_schedule (
+  id bigserial primary key,
+  inspector_user_id bigint not null references inspector_user (id) on delete cascade,
+  weekday smallint not null check (weekday between 1 and 7), -- ISO day of week, 1 is Monday
+  from_time time not null,
+  to_time time not null check (to_time > from_time)
+);
+
+create index inspector_schedule_inspector_user_id_idx on inspector_schedule (inspector_user_id);
+
+create type inspector_absence_kind as enum ('vacation', 'sick_leave', 'other');
+
+create table inspector_absence (
+  id bigserial primary key,
+  inspector_user_id bigint not null references inspector_user (id) on delete cascade,
+  kind inspector_absence_kind not null,
+  from_time timestamptz not null,
+  to_time timestamptz not null check (to_time > from_time),
+  comment text not null default ''
+);
+
+create index inspector_absence_inspector_user_id_idx on inspector_absence (inspector_user_id, to_time);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table inspector_absence;
+drop type inspector_absence_kind;
+drop table inspector_schedule;
+-- +goose StatementEnd
@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Recurring weekly rules from which the consultation slots of an authority are generated
+create table slot_template (
+  id bigserial primary key,
+  authority_id bigint not null references authority (id) on delete cascade,
+  weekday smallint not null check (weekday between 1 and 7), -- ISO day of week, 1 is Monday
+  from_time time not null,
+  to_time time not null check (to_time > from_time),
+  slot_minutes integer not null check (slot_minutes > 0),
+  valid_from date not null,
+  valid_until date, -- null for templates which are valid indefinitely
+  created_at timestamptz not null default now()
+);
+
+-- Dates on which no slots are generated for the authority, such as holidays
+create table slot_exception (
+  id bigserial primary key,
+  authority_id bigint not null references authority (id) on delete cascade,
+  date date not null,
+  comment text not null default '',
+  unique (authority_id, date)
+);
+
+-- Generated slots reference their template so that they can be removed when it changes
+alter table authority_consultation_slots add column template_id bigint references slot_template (id) on delete set null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table authority_consultation_slots drop column template_id;
+drop table slot_exception;
+drop table slot_template;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Topics which the inspectors are competent in, inspectors without any can handle all of their authority's topics
+create table inspector_topic (
+  inspector_user_id bigint not null references inspector_user (id) on delete cascade,
+  topic_id bigint not null references authority_consultation_topic (id) on delete cascade,
+  primary key (inspector_user_id, topic_id)
+);
+
+create index inspector_topic_topic_id_idx on inspector_topic (topic_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table inspector_topic;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+create type assignment_strategy as enum ('random', 'least_loaded', 'round_robin', 'previous_inspector');
+
+alter table authority add column assignment_strategy assignment_strategy not null default 'random';
+
+-- How and why the inspector was chosen, for auditing
+alter table consultation_appointment add column assigned_at timestamptz not null default now();
+alter table consultation_appointment add column assignment_strategy assignment_strategy;
+alter table consultation_appointment add column assignment_reason text;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table consultation_appointment drop column assignment_reason;
+alter table consultation_appointment drop column assignment_strategy;
+alter table consultation_appointment drop column assigned_at;
+alter table authority drop column assignment_strategy;
+drop type assignment_strategy;
+-- +goose StatementEnd
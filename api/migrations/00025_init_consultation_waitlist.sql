@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Business users waiting for a consultation slot to open up in a range of dates,
+-- served in the order of joining by booking the first matching available slot
+create table consultation_waitlist (
+  id bigserial primary key,
+  business_user_id bigint not null references business_user (id) on delete cascade,
+  authority_id bigint not null references authority (id) on delete cascade,
+  topic_id bigint not null references authority_consultation_topic (id) on delete cascade,
+  from_date date not null,
+  to_date date not null,
+  created_at timestamptz not null default now(),
+  -- Set once a slot has been booked for the business user
+  fulfilled_at timestamptz,
+  appointment_id uuid references consultation_appointment (id) on delete set null,
+  check (from_date <= to_date)
+);
+
+-- Only one pending entry per topic, otherwise a user could occupy several slots at once
+create unique index consultation_waitlist_pending_idx on consultation_waitlist (business_user_id, topic_id) where fulfilled_at is null;
+create index consultation_waitlist_authority_id_idx on consultation_waitlist (authority_id) where fulfilled_at is null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table consultation_waitlist;
+-- +goose StatementEnd
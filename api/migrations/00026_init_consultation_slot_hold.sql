@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Temporary reservations of an inspector in a slot while the business user is finishing the booking,
+-- the inspector is treated as taken in the slot until the hold expires
+create table consultation_slot_hold (
+  id bigserial primary key,
+  token_hash bytea unique not null,
+  slot_id bigint not null references authority_consultation_slots (id) on delete cascade,
+  business_user_id bigint not null references business_user (id) on delete cascade,
+  inspector_user_id bigint not null references inspector_user (id) on delete cascade,
+  assignment_strategy assignment_strategy,
+  assignment_reason text,
+  created_at timestamptz not null default now(),
+  expires_at timestamptz not null,
+  unique (slot_id, inspector_user_id)
+);
+
+create index consultation_slot_hold_expires_at_idx on consultation_slot_hold (expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table consultation_slot_hold;
+-- +goose StatementEnd
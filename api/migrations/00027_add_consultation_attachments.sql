@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Question which the business user wants to discuss during the consultation
+alter table consultation_appointment add column question text;
+
+-- Files attached to the appointments by the business users, the contents are kept in the blob store
+create table consultation_attachment (
+  id bigserial primary key,
+  appointment_id uuid not null references consultation_appointment (id) on delete cascade,
+  name text not null,
+  content_type text not null,
+  size bigint not null,
+  blob_key text unique not null,
+  created_at timestamptz not null default now()
+);
+
+create index consultation_attachment_appointment_id_idx on consultation_attachment (appointment_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table consultation_attachment;
+alter table consultation_appointment drop column question;
+-- +goose StatementEnd
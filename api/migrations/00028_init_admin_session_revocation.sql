@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Admin sessions signed out before they expire, only kept until then
+create table revoked_admin_session (
+  session_id text primary key,
+  admin_user_id bigint not null references admin_user (id) on delete cascade,
+  expires_at timestamptz not null
+);
+
+create index revoked_admin_session_expires_at_idx on revoked_admin_session (expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table revoked_admin_session;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Set when the assigned inspector cancels the appointment themselves, which keeps them unavailable in its slot
+alter table consultation_appointment add column declined_by_inspector boolean not null default false;
+
+-- Authority cancelations have only been made by the assigned inspectors before the supervisors and integrations could cancel
+update consultation_appointment set declined_by_inspector = true where canceled_by = 'authority';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table consultation_appointment drop column declined_by_inspector;
+-- +goose StatementEnd